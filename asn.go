@@ -0,0 +1,29 @@
+package anot
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ASNDatabase maps addresses to their autonomous system number, for the
+// asn:NUMBER patterns
+type ASNDatabase interface {
+	LookupASN(ip net.IP) (uint32, bool)
+}
+
+// parseASNPattern parses an asn:16509 (or asn:AS16509) pattern
+func parseASNPattern(pattern string) (uint32, bool) {
+	if len(pattern) < 4 || !strings.EqualFold(pattern[:4], "asn:") {
+		return 0, false
+	}
+	v := pattern[4:]
+	if len(v) > 2 && strings.EqualFold(v[:2], "as") {
+		v = v[2:]
+	}
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(n), true
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// unresponsiveRule is the rule reported for hosts failing an --alive probe
+var unresponsiveRule = &anot.Rule{Pattern: "unresponsive", Action: anot.ActionRemove}
+
+// aliveCheck probes hosts over TCP or HTTP(S) and removes the ones that do
+// not answer in time
+type aliveCheck struct {
+	scheme  string // "tcp", "http" or "https"
+	port    string // empty for the scheme's default
+	timeout time.Duration
+	client  *http.Client
+}
+
+// newAliveCheck parses a probe spec: tcp:PORT, http[:PORT] or https[:PORT]
+func newAliveCheck(spec string, timeout time.Duration) (*aliveCheck, error) {
+	scheme, port, _ := strings.Cut(spec, ":")
+	switch scheme {
+	case "tcp":
+		if port == "" {
+			return nil, fmt.Errorf("alive probe %q needs a port, e.g. tcp:443", spec)
+		}
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unknown alive probe %q (want tcp:PORT, http[:PORT] or https[:PORT])", spec)
+	}
+	if port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("alive probe %q: bad port %q", spec, port)
+		}
+	}
+
+	a := &aliveCheck{scheme: scheme, port: port, timeout: timeout}
+	if scheme != "tcp" {
+		a.client = &http.Client{
+			Timeout: timeout,
+			// Any answer means the host is alive, redirects included
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+			Transport: &http.Transport{
+				TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+				DisableKeepAlives: true,
+			},
+		}
+	}
+	return a, nil
+}
+
+func (a *aliveCheck) wants(key string) bool {
+	host := hostFromToken(key)
+	return looksLikeDomain(host) || net.ParseIP(host) != nil
+}
+
+func (a *aliveCheck) check(ctx context.Context, key string) *anot.Rule {
+	if a.alive(ctx, key) {
+		return nil
+	}
+	return unresponsiveRule
+}
+
+// alive probes one host
+func (a *aliveCheck) alive(ctx context.Context, key string) bool {
+	host := hostFromToken(key)
+	netRate.wait(ctx)
+
+	if a.scheme == "tcp" {
+		dialer := &net.Dialer{Timeout: a.timeout}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, a.port))
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+
+	target := a.scheme + "://" + host
+	if strings.Contains(host, ":") {
+		target = a.scheme + "://[" + host + "]"
+	}
+	if a.port != "" {
+		target = a.scheme + "://" + net.JoinHostPort(host, a.port)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target+"/", nil)
+	if err != nil {
+		return false
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// openASNDatabase opens a MaxMind DB (GeoLite2-ASN) or a CSV/TSV range
+// list, told apart by the MaxMind DB metadata marker
+func openASNDatabase(fn string) (anot.ASNDatabase, error) {
+	if db, err := openMMDB(fn); err == nil {
+		return &mmdbASN{db: db}, nil
+	}
+	return readASNRanges(fn)
+}
+
+// mmdbASN looks numbers up in a GeoLite2-ASN style MaxMind DB
+type mmdbASN struct {
+	db *mmdbReader
+}
+
+func (m *mmdbASN) LookupASN(ip net.IP) (uint32, bool) {
+	record, err := m.db.lookup(ip)
+	if err != nil || record == nil {
+		return 0, false
+	}
+	n, ok := mmdbPath(record, "autonomous_system_number").(uint64)
+	return uint32(n), ok
+}
+
+// asnRange is one address range announced by an autonomous system
+type asnRange struct {
+	start, end u128
+	asn        uint32
+}
+
+// asnRanges is a sorted list of non-overlapping ranges, searched by binary
+// search
+type asnRanges []asnRange
+
+func (r asnRanges) LookupASN(ip net.IP) (uint32, bool) {
+	a := ipToU128(ip)
+	i := sort.Search(len(r), func(i int) bool { return a.less(r[i].start) })
+	if i == 0 || r[i-1].end.less(a) {
+		return 0, false
+	}
+	return r[i-1].asn, true
+}
+
+// readASNRanges reads a comma or tab separated ASN list, either with a
+// CIDR and a number per row (GeoLite2-ASN-Blocks CSV) or with a start
+// address, an end address and a number (iptoasn.com TSV). Header and
+// unparsable rows are skipped.
+func readASNRanges(fn string) (asnRanges, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ranges asnRanges
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sep := ","
+		if strings.Contains(line, "\t") {
+			sep = "\t"
+		}
+		fields := strings.Split(line, sep)
+		for i := range fields {
+			fields[i] = strings.Trim(strings.TrimSpace(fields[i]), `"`)
+		}
+
+		var r asnRange
+		var asnField string
+		if _, network, err := net.ParseCIDR(fields[0]); err == nil && len(fields) >= 2 {
+			ones, width := network.Mask.Size()
+			r.start = ipToU128(network.IP)
+			r.end = r.start.add(mask(width - ones))
+			asnField = fields[1]
+		} else if len(fields) >= 3 {
+			start, end := net.ParseIP(fields[0]), net.ParseIP(fields[1])
+			if start == nil || end == nil {
+				continue
+			}
+			r.start, r.end = ipToU128(start), ipToU128(end)
+			asnField = fields[2]
+		} else {
+			continue
+		}
+
+		n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(asnField), "AS"), 10, 32)
+		if err != nil || n == 0 {
+			// iptoasn uses 0 for unannounced space
+			continue
+		}
+		r.asn = uint32(n)
+		ranges = append(ranges, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("%s: no ASN ranges found", fn)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start.less(ranges[j].start) })
+	return ranges, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestASNDatabase(t *testing.T) {
+	var record []byte
+	record = append(record, mmdbMapHeader(1)...)
+	record = append(record, mmdbStr("autonomous_system_number")...)
+	record = append(record, mmdbUint32Value(64500)...)
+	mmdbFile := writeTestMMDB(t, record)
+
+	csvFile := filepath.Join(t.TempDir(), "asn.csv")
+	csv := "network,autonomous_system_number,autonomous_system_organization\n" +
+		"10.0.0.0/8,64500,Example\n" +
+		"2001:db8::/32,64501,Example6\n"
+	if err := os.WriteFile(csvFile, []byte(csv), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tsvFile := filepath.Join(t.TempDir(), "asn.tsv")
+	tsv := "10.0.0.0\t10.255.255.255\t64500\tUS\tExample\n" +
+		"192.0.2.0\t192.0.2.255\t0\tNone\tNot routed\n"
+	if err := os.WriteFile(tsvFile, []byte(tsv), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		fn   string
+		ip   string
+		asn  uint32
+		ok   bool
+	}{
+		{"mmdb", mmdbFile, "10.0.0.1", 64500, true},
+		{"mmdb outside", mmdbFile, "192.0.2.1", 0, false},
+		{"csv", csvFile, "10.255.255.255", 64500, true},
+		{"csv ipv6", csvFile, "2001:db8::1", 64501, true},
+		{"csv outside", csvFile, "11.0.0.1", 0, false},
+		{"tsv", tsvFile, "10.1.2.3", 64500, true},
+		{"tsv unannounced", tsvFile, "192.0.2.1", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := openASNDatabase(tt.fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			asn, ok := db.LookupASN(net.ParseIP(tt.ip))
+			if asn != tt.asn || ok != tt.ok {
+				t.Errorf("LookupASN(%s) = %d, %v, want %d, %v", tt.ip, asn, ok, tt.asn, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// cacheEntry is one cached lookup result with its expiry (unix seconds)
+type cacheEntry struct {
+	Value   json.RawMessage `json:"v"`
+	Expires int64           `json:"e"`
+}
+
+// diskCache persists lookup results (DNS answers, and any other slow
+// network lookup) across runs in a JSON file, each entry expiring after
+// the configured TTL. A nil cache stores nothing.
+type diskCache struct {
+	path string
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	dirty   bool
+}
+
+// defaultCachePath returns the cache file in the user's cache directory
+func defaultCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "anot", "cache.json"), nil
+}
+
+// openCache loads the cache file, a missing one being an empty cache.
+// Expired entries are dropped on load.
+func openCache(path string, ttl time.Duration) (*diskCache, error) {
+	c := &diskCache{path: path, ttl: ttl, entries: make(map[string]cacheEntry)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		// A corrupt cache is only a slower run, start over
+		c.entries = make(map[string]cacheEntry)
+		c.dirty = true
+		return c, nil
+	}
+
+	now := time.Now().Unix()
+	for key, entry := range c.entries {
+		if entry.Expires <= now {
+			delete(c.entries, key)
+			c.dirty = true
+		}
+	}
+	return c, nil
+}
+
+// get decodes the cached value of a key into v and reports whether there
+// was a live entry
+func (c *diskCache) get(key string, v interface{}) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok || entry.Expires <= time.Now().Unix() {
+		return false
+	}
+	return json.Unmarshal(entry.Value, v) == nil
+}
+
+// put stores the value of a key
+func (c *diskCache) put(key string, v interface{}) {
+	if c == nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries[key] = cacheEntry{Value: data, Expires: time.Now().Add(c.ttl).Unix()}
+	c.dirty = true
+	c.mu.Unlock()
+}
+
+// save writes the cache file back if anything changed
+func (c *diskCache) save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return writeAtomic(c.path, func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(c.entries)
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "cache.json")
+	c, err := openCache(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.put("host:example.com", []string{"192.0.2.1"})
+	if err := c.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = openCache(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var addrs []string
+	if !c.get("host:example.com", &addrs) || len(addrs) != 1 || addrs[0] != "192.0.2.1" {
+		t.Errorf("get = %q after reopening", addrs)
+	}
+	if c.get("host:other.example.com", &addrs) {
+		t.Error("got an entry that was never put")
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		live bool
+	}{
+		{"live", `{"k":{"v":1,"e":99999999999}}`, true},
+		{"expired", `{"k":{"v":1,"e":1}}`, false},
+		{"corrupt", `{"k":`, false},
+		{"wrong type", `{"k":{"v":"one","e":99999999999}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cache.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			c, err := openCache(path, time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var n int
+			if got := c.get("k", &n); got != tt.live {
+				t.Errorf("get = %v, want %v", got, tt.live)
+			}
+		})
+	}
+}
+
+func TestCacheUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	c, err := openCache(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("an unchanged cache was written")
+	}
+
+	// A nil cache stores nothing
+	var nilCache *diskCache
+	nilCache.put("k", 1)
+	if nilCache.get("k", new(int)) || nilCache.save() != nil {
+		t.Error("a nil cache stored an entry")
+	}
+}
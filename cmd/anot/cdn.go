@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"sort"
+
+	"github.com/hasshido/anot"
+)
+
+// cdnProviders lists the edge ranges and CNAME targets of the big CDNs,
+// as published by the providers (Cloudflare ips-v4/v6, Fastly public IP
+// list, AWS ip-ranges CLOUDFRONT) and the Akamai AS20940 announcements.
+// The ranges change over time, so --cdn-ranges can supply a fresher list.
+var cdnProviders = map[string][]string{
+	"akamai": {
+		"2.16.0.0/13", "23.0.0.0/12", "23.32.0.0/11", "23.64.0.0/14",
+		"23.72.0.0/13", "23.192.0.0/11", "72.246.0.0/15", "88.221.0.0/16",
+		"92.122.0.0/15", "95.100.0.0/15", "96.6.0.0/15", "96.16.0.0/15",
+		"104.64.0.0/10", "118.214.0.0/16", "173.222.0.0/15", "184.24.0.0/13",
+		"184.50.0.0/15", "184.84.0.0/14",
+		"2600:1400::/24", "2a02:26f0::/29",
+		"*.akamai.net", "*.akamaiedge.net", "*.akamaized.net", "*.edgekey.net",
+		"*.edgesuite.net",
+	},
+	"cloudflare": {
+		"103.21.244.0/22", "103.22.200.0/22", "103.31.4.0/22", "104.16.0.0/13",
+		"104.24.0.0/14", "108.162.192.0/18", "131.0.72.0/22", "141.101.64.0/18",
+		"162.158.0.0/15", "172.64.0.0/13", "173.245.48.0/20", "188.114.96.0/20",
+		"190.93.240.0/20", "197.234.240.0/22", "198.41.128.0/17",
+		"2400:cb00::/32", "2405:8100::/32", "2405:b500::/32", "2606:4700::/32",
+		"2803:f800::/32", "2a06:98c0::/29", "2c0f:f248::/32",
+		"*.cdn.cloudflare.net",
+	},
+	"cloudfront": {
+		"3.160.0.0/14", "13.32.0.0/15", "13.35.0.0/16", "13.224.0.0/14",
+		"13.249.0.0/16", "18.64.0.0/14", "18.154.0.0/15", "18.160.0.0/15",
+		"18.164.0.0/15", "18.172.0.0/15", "18.238.0.0/15", "18.244.0.0/15",
+		"52.84.0.0/15", "52.222.128.0/17", "54.182.0.0/16", "54.192.0.0/16",
+		"54.230.0.0/17", "54.239.128.0/18", "54.240.128.0/18", "64.252.64.0/18",
+		"64.252.128.0/18", "65.8.0.0/16", "65.9.0.0/17", "70.132.0.0/18",
+		"71.152.0.0/17", "99.84.0.0/16", "99.86.0.0/16", "108.156.0.0/14",
+		"130.176.0.0/16", "143.204.0.0/16", "204.246.164.0/22",
+		"205.251.192.0/19", "216.137.32.0/19",
+		"2600:9000::/28",
+		"*.cloudfront.net",
+	},
+	"fastly": {
+		"23.235.32.0/20", "43.249.72.0/22", "103.244.50.0/24", "103.245.222.0/23",
+		"103.245.224.0/24", "104.156.80.0/20", "140.248.64.0/18",
+		"140.248.128.0/17", "146.75.0.0/17", "151.101.0.0/16", "157.52.64.0/18",
+		"167.82.0.0/17", "167.82.128.0/20", "167.82.160.0/20", "167.82.224.0/20",
+		"172.111.64.0/18", "185.31.16.0/22", "199.27.72.0/21", "199.232.0.0/16",
+		"2a04:4e40::/32", "2a04:4e42::/32",
+		"*.fastly.net", "*.fastlylb.net",
+	},
+}
+
+// cdnRules returns removal rules for every CDN range and CNAME target, from
+// the built-in list or, when fn is set, from a file of one pattern per line
+func cdnRules(fn string) ([]*anot.Rule, error) {
+	if fn != "" {
+		f, err := os.Open(fn)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return anot.ReadRules(f, true, true)
+	}
+
+	names := make([]string, 0, len(cdnProviders))
+	for name := range cdnProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var rules []*anot.Rule
+	for _, name := range names {
+		for _, pattern := range cdnProviders[name] {
+			rules = append(rules, &anot.Rule{Pattern: pattern, Action: anot.ActionRemove})
+		}
+	}
+	return rules, nil
+}
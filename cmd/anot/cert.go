@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// certDialTimeout bounds the TLS handshake of --from-cert host:port
+const certDialTimeout = 10 * time.Second
+
+// certRules turns the names of a TLS certificate into removal rules. The
+// source is a PEM file, or host:port to fetch the certificate from. The
+// subject CN, DNS SANs (wildcards included) and IP SANs are all used.
+func certRules(ctx context.Context, source string) ([]*anot.Rule, error) {
+	cert, err := loadCertificate(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var rules []*anot.Rule
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			rules = append(rules, &anot.Rule{Pattern: name})
+		}
+	}
+
+	if cn := cert.Subject.CommonName; looksLikeDomain(cn) || net.ParseIP(cn) != nil {
+		add(cn)
+	}
+	for _, name := range cert.DNSNames {
+		add(name)
+	}
+	for _, ip := range cert.IPAddresses {
+		add(ip.String())
+	}
+	return rules, nil
+}
+
+// loadCertificate reads the leaf certificate from a PEM file, or from a TLS
+// server when source is not a file but a host:port
+func loadCertificate(ctx context.Context, source string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(source)
+	if err == nil {
+		for {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				return nil, fmt.Errorf("%s: no PEM certificate found", source)
+			}
+			if block.Type == "CERTIFICATE" {
+				return x509.ParseCertificate(block.Bytes)
+			}
+		}
+	}
+	if _, _, splitErr := net.SplitHostPort(source); splitErr != nil {
+		return nil, err
+	}
+
+	// Only the names are needed, so the chain is deliberately not verified
+	netRate.wait(ctx)
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: certDialTimeout}, Config: &tls.Config{InsecureSkipVerify: true}}
+	conn, err := dialer.DialContext(ctx, "tcp", source)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("%s: server sent no certificate", source)
+	}
+	return certs[0], nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/gob"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hasshido/anot"
+)
+
+// writeCompiled stores compiled patterns in fn atomically
+func writeCompiled(fn string, c *anot.CompiledPatterns) error {
+	return writeAtomic(fn, func(w io.Writer) error {
+		return gob.NewEncoder(w).Encode(c)
+	})
+}
+
+// readCompiled loads compiled patterns from fn
+func readCompiled(fn string) (*anot.CompiledPatterns, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	c := &anot.CompiledPatterns{}
+	if err := gob.NewDecoder(f).Decode(c); err != nil {
+		return nil, fmt.Errorf("%s: not a compiled pattern file: %s", fn, err)
+	}
+	return c, nil
+}
+
+// compileCachePath returns where the compile cache keeps the matcher for
+// rules with the given hash
+func compileCachePath(hash string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "anot", "compiled", hash+".v"+strconv.Itoa(anot.CompiledVersion)), nil
+}
+
+// cachedMatcher returns the matcher for rules from the compile cache, or
+// compiles it and stores it there for the next run. The matcher is always
+// usable: an error only says the cache couldn't be used, a warning.
+func cachedMatcher(rules []*anot.Rule) (*anot.Matcher, error) {
+	hash := anot.RulesHash(rules)
+	path, err := compileCachePath(hash)
+	if err != nil {
+		return anot.NewMatcher(rules), err
+	}
+	if c, err := readCompiled(path); err == nil && c.Hash == hash {
+		if m, err := c.Matcher(rules); err == nil {
+			return m, nil
+		}
+	}
+
+	m := anot.NewMatcher(rules)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return m, err
+	}
+	return m, writeCompiled(path, anot.Compile(rules, m))
+}
+
+// runCompile implements "anot compile": the pattern files, or stdin, are
+// compiled into one artifact that "anot --compiled" loads without parsing
+// or compiling the patterns again
+func runCompile(args []string) error {
+	fs := flag.NewFlagSet("anot compile", flag.ExitOnError)
+	var out string
+	var patternFiles stringList
+	var trim bool
+	var extended bool
+	fs.StringVar(&out, "o", "", "write the compiled patterns to this file (required)")
+	fs.Var(&patternFiles, "p", "read patterns from this file instead of stdin (repeatable)")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace of the patterns")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot compile [options] -o scope.anotc [-p patterns.txt...]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	// Pattern files given as arguments are the same as -p
+	patternFiles = append(patternFiles, fs.Args()...)
+
+	if out == "" {
+		return usageError("no output file provided")
+	}
+	rules, err := loadRules(patternFiles, extended, trim)
+	if err != nil {
+		return patternsError(err)
+	}
+
+	// whois-org: patterns never reach the matcher, whatever the run
+	matched, _ := splitWhoisPatterns(rules)
+	c := anot.Compile(matched, anot.NewMatcher(matched))
+	c.KeepRules(rules)
+	if err := writeCompiled(out, c); err != nil {
+		return ioError(out, fmt.Errorf("failed to write file: %s", err))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestCachedMatcherFailure(t *testing.T) {
+	rules, err := anot.ReadRules(strings.NewReader("10.0.0.1\n*.example.com\n"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A cache directory that can't be created, and none at all
+	notDir := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(notDir, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for name, env := range map[string][2]string{
+		"unwritable": {notDir, os.Getenv("HOME")},
+		"no cache":   {"", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("XDG_CACHE_HOME", env[0])
+			t.Setenv("HOME", env[1])
+			m, err := cachedMatcher(rules)
+			if err == nil {
+				t.Error("no error for a cache that can't be written")
+			}
+			if m == nil {
+				t.Fatal("no matcher")
+			}
+			if m.Match("10.0.0.1") == nil || m.Match("a.example.com") == nil {
+				t.Error("the matcher doesn't match the patterns")
+			}
+			if m.Match("10.0.0.2") != nil {
+				t.Error("the matcher matches what it shouldn't")
+			}
+		})
+	}
+}
+
+func TestRunCompilePatternFiles(t *testing.T) {
+	dir := t.TempDir()
+	for fn, data := range map[string]string{"a.txt": "10.0.0.1\n", "b.txt": "*.example.com\n"} {
+		if err := os.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// -p files come first, then the files given as arguments
+	status, stderr := runAnot(t, dir, "", "compile", "-o", "scope.anotc", "-p", "a.txt", "b.txt")
+	if status != 0 {
+		t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+	}
+	c, err := readCompiled(filepath.Join(dir, "scope.anotc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var patterns []string
+	for _, rule := range c.StoredRules() {
+		patterns = append(patterns, rule.Pattern)
+	}
+	if got := strings.Join(patterns, " "); got != "10.0.0.1 *.example.com" {
+		t.Errorf("compiled patterns %q", got)
+	}
+}
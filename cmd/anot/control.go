@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// controller is a long running mode driven through its control socket
+type controller interface {
+	// status describes the mode as space separated key=value pairs
+	status() string
+	// reload loads the patterns from their files again
+	reload() (int, error)
+	// addPatterns adds patterns until the next reload from the files
+	addPatterns(rules []*anot.Rule) (int, error)
+	// drain finishes the work in progress, then stops the mode
+	drain()
+}
+
+// listenUnix listens on a unix socket only the user may connect to,
+// replacing one left over from a process that died
+func listenUnix(path, who string) (net.Listener, error) {
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("%s is already listening on %s", who, path)
+	}
+	os.Remove(path)
+	return listenPrivate(path)
+}
+
+// serveControl answers the commands sent to the control socket ln, one per
+// line, each with a line starting with "ok" or "error:", until ln closes.
+// Patterns are added in the syntax of the pattern files. stopped receives
+// once a drain is answered and the mode may stop.
+func serveControl(ln net.Listener, c controller, extended, trim bool, stopped chan<- struct{}) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				reply, stop := runControlCommand(c, scanner.Text(), extended, trim)
+				_, err := fmt.Fprintln(conn, reply)
+				if stop {
+					select {
+					case stopped <- struct{}{}:
+					default:
+					}
+				}
+				if err != nil || stop {
+					return
+				}
+			}
+		}()
+	}
+}
+
+// runControlCommand runs one command line, returning the reply and whether
+// the mode stops
+func runControlCommand(c controller, line string, extended, trim bool) (string, bool) {
+	cmd, arg := strings.TrimSpace(line), ""
+	if i := strings.IndexAny(cmd, " \t"); i >= 0 {
+		cmd, arg = cmd[:i], strings.TrimSpace(cmd[i+1:])
+	}
+	switch cmd {
+	case "status":
+		return "ok " + c.status(), false
+	case "reload":
+		n, err := c.reload()
+		if err != nil {
+			return "error: " + err.Error(), false
+		}
+		return fmt.Sprintf("ok patterns=%d", n), false
+	case "add-pattern":
+		rules, err := anot.ReadRules(strings.NewReader(arg), extended, trim)
+		if err == nil && len(rules) == 0 {
+			err = fmt.Errorf("add-pattern needs a pattern")
+		}
+		if err != nil {
+			return "error: " + err.Error(), false
+		}
+		n, err := c.addPatterns(rules)
+		if err != nil {
+			return "error: " + err.Error(), false
+		}
+		return fmt.Sprintf("ok patterns=%d", n), false
+	case "drain":
+		c.drain()
+		return "ok drained", true
+	case "help":
+		return "ok commands: status, reload, add-pattern PATTERN, drain", false
+	case "":
+		return "error: empty command", false
+	}
+	return fmt.Sprintf("error: unknown command %q, try help", cmd), false
+}
+
+// runControl implements "anot control": one command is sent to the control
+// socket of a daemon or watch and the reply printed. Failures exit 1, as
+// the scripts sending commands need to see them.
+func runControl(args []string) {
+	fs := flag.NewFlagSet("anot control", flag.ExitOnError)
+	var socketPath string
+	fs.StringVar(&socketPath, "s", "", "control socket, as given to -control")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot control -s socket status|reload|add-pattern PATTERN|drain\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if socketPath == "" || fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: nothing listening: %s\n", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+	fmt.Fprintln(conn, strings.Join(fs.Args(), " "))
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil && err != io.EOF {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+	reply = strings.TrimSuffix(reply, "\n")
+	if !strings.HasPrefix(reply, "ok") {
+		fmt.Fprintln(os.Stderr, reply)
+		os.Exit(1)
+	}
+	fmt.Println(strings.TrimSpace(strings.TrimPrefix(reply, "ok")))
+}
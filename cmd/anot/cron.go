@@ -0,0 +1,281 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// cronSchedule is a parsed crontab time specification; each field holds the
+// allowed values as bits
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny record a day field starting with "*", such as "*"
+	// or "*/2": when both day fields are restricted, a day matching either
+	// one is a match, as in cron
+	domAny, dowAny bool
+	// every is the interval of an "@every 10m" schedule instead
+	every time.Duration
+}
+
+var cronShortcuts = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+var cronDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// parseCron parses "MIN HOUR DOM MON DOW" with lists, ranges, steps and
+// month and day names, the @hourly style shortcuts, or "@every DURATION"
+func parseCron(spec string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d := strings.TrimPrefix(spec, "@every "); d != spec {
+		every, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil || every < time.Second {
+			return nil, fmt.Errorf("invalid schedule %q: want @every with a duration of at least 1s", spec)
+		}
+		return &cronSchedule{every: every}, nil
+	}
+	if s, ok := cronShortcuts[spec]; ok {
+		spec = s
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: want 5 fields, minute hour day-of-month month day-of-week", spec)
+	}
+
+	s := &cronSchedule{domAny: strings.HasPrefix(fields[2], "*"), dowAny: strings.HasPrefix(fields[4], "*")}
+	var err error
+	bits := []*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	limits := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	names := [][]string{nil, nil, nil, cronMonths, cronDays}
+	for i, field := range fields {
+		if *bits[i], err = parseCronField(field, limits[i][0], limits[i][1], names[i]); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %s", spec, err)
+		}
+	}
+	// Sunday is both 0 and 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// parseCronField parses one comma separated field of values from lo to hi
+func parseCronField(field string, lo, hi int, names []string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			step, part = n, part[:i]
+		}
+		start, end := lo, hi
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if start, err = cronValue(bounds[0], lo, hi, names); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = cronValue(bounds[1], lo, hi, names); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				// "5/15" runs from 5 to the end
+				end = hi
+			}
+			if end < start {
+				return 0, fmt.Errorf("bad range %q", part)
+			}
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func cronValue(s string, lo, hi int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			// Month names count from 1, day names from 0
+			return i + lo, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < lo || v > hi {
+		return 0, fmt.Errorf("%q is not a value from %d to %d", s, lo, hi)
+	}
+	return v, nil
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.dowAny:
+		return dom
+	case s.domAny:
+		return dow
+	default:
+		return dom || dow
+	}
+}
+
+// next returns the first time after t the schedule fires, or the zero time
+// when it never does (such as on February 30th)
+func (s *cronSchedule) next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Add(s.every)
+	}
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// runCron implements "anot cron": in the foreground, anot runs with the
+// given options on every file matching the -glob patterns whenever the
+// schedule fires, for containers without a cron daemon. Each run is a new
+// process, so pattern files are read afresh and a failing run never stops
+// the schedule.
+func runCron(args []string) error {
+	usage := func() {
+		fmt.Fprintf(os.Stderr, "usage: anot cron SCHEDULE [-glob PATTERN]... [options] [filename...]\n")
+		fmt.Fprintf(os.Stderr, "SCHEDULE is \"MIN HOUR DOM MON DOW\", @hourly, @daily, @weekly, @monthly or @every DURATION;\n")
+		fmt.Fprintf(os.Stderr, "the options are those of anot, -glob is repeatable\n")
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		usage()
+		os.Exit(2)
+	}
+	schedule, err := parseCron(args[0])
+	if err != nil {
+		return usageError("%s", err)
+	}
+
+	// -glob is ours, everything else is passed on
+	var globs, runArgs []string
+	var hasPatterns bool
+	rest := args[1:]
+	for i := 0; i < len(rest); i++ {
+		arg := rest[i]
+		name := strings.TrimLeft(arg, "-")
+		if arg != name {
+			switch strings.SplitN(name, "=", 2)[0] {
+			case "p", "compiled", "server", "from-cert":
+				hasPatterns = true
+			}
+		}
+		switch {
+		case arg != name && name == "glob":
+			if i+1 == len(rest) {
+				return usageError("-glob needs a pattern")
+			}
+			i++
+			globs = append(globs, rest[i])
+		case arg != name && strings.HasPrefix(name, "glob="):
+			globs = append(globs, strings.TrimPrefix(name, "glob="))
+		default:
+			runArgs = append(runArgs, arg)
+		}
+	}
+	// The runs have no stdin to read patterns from
+	if !hasPatterns {
+		return usageError("cron needs pattern files (-p) or -compiled")
+	}
+	for _, g := range globs {
+		if _, err := filepath.Match(g, ""); err != nil {
+			return usageError("-glob %q: %s", g, err)
+		}
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return generalError(err)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	for {
+		at := schedule.next(time.Now())
+		if at.IsZero() {
+			return usageError("the schedule %q never fires", args[0])
+		}
+		fmt.Fprintf(os.Stderr, "next run at %s\n", at.Format(time.RFC3339))
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-timer.C:
+		case <-signals:
+			timer.Stop()
+			return nil
+		}
+
+		// A file matching several globs is filtered once
+		var files []string
+		seen := map[string]bool{}
+		for _, g := range globs {
+			matches, _ := filepath.Glob(g)
+			for _, fn := range matches {
+				if !seen[fn] {
+					seen[fn] = true
+					files = append(files, fn)
+				}
+			}
+		}
+		if len(globs) > 0 && len(files) == 0 {
+			fmt.Fprintf(os.Stderr, "warning: no files match %s, skipping this run\n", strings.Join(globs, " "))
+			continue
+		}
+
+		// Runs that overrun the schedule skip what they missed
+		cmd := exec.Command(self, append(append([]string(nil), runArgs...), files...)...)
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if err := cmd.Start(); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err)
+			continue
+		}
+		done := make(chan error, 1)
+		go func() { done <- cmd.Wait() }()
+		select {
+		case err = <-done:
+		case <-signals:
+			// Let the run finish before stopping; Ctrl-C in a terminal
+			// reaches it directly
+			<-done
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: scheduled run failed: %s\n", err)
+		}
+	}
+}
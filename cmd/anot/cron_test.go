@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	// A Thursday
+	from := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		spec string
+		want time.Time
+	}{
+		{"hourly", "0 * * * *", at(1, 1, 11, 0)},
+		{"minute step", "*/15 * * * *", at(1, 1, 10, 45)},
+		{"monthly", "0 0 1 * *", at(2, 1, 0, 0)},
+		{"day of month or week", "0 0 1,15 * mon", at(1, 5, 0, 0)},
+		{"day of month step is any", "0 0 */2 * mon", at(1, 5, 0, 0)},
+		{"day of week step is any", "0 0 3 * */2", at(1, 3, 0, 0)},
+		{"sunday as 7", "0 0 * * 7", at(1, 4, 0, 0)},
+		{"names and ranges", "0 9 * jan-feb mon-fri", at(1, 2, 9, 0)},
+		{"start with step", "5/20 * * * *", at(1, 1, 10, 45)},
+		{"shortcut", "@daily", at(1, 2, 0, 0)},
+		{"every", "@every 10m", at(1, 1, 10, 40)},
+		{"never", "0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := parseCron(test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.next(from); !got.Equal(test.want) {
+				t.Errorf("next(%s) = %s, want %s", from, got, test.want)
+			}
+		})
+	}
+
+	for _, spec := range []string{
+		"61 * * * *",
+		"* * * *",
+		"* * * * * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+		"* * 0 * *",
+		"@every 100ms",
+		"@every soon",
+	} {
+		if _, err := parseCron(spec); err == nil {
+			t.Errorf("parseCron(%q) succeeded, want an error", spec)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// groupCSVRecords joins physical lines into logical CSV records, so quoted
+// fields with embedded newlines stay in one entry. Records keep their raw
+// text, which means rewriting the file preserves the original quoting.
+func groupCSVRecords(lines []string) []string {
+	var records []string
+	var pending []string
+	quotes := 0
+	for _, line := range lines {
+		pending = append(pending, line)
+		quotes += strings.Count(line, `"`)
+		// An odd number of quotes means a quoted field continues on the next line
+		if quotes%2 == 0 {
+			records = append(records, strings.Join(pending, "\n"))
+			pending = pending[:0]
+			quotes = 0
+		}
+	}
+	if len(pending) > 0 {
+		records = append(records, strings.Join(pending, "\n"))
+	}
+	return records
+}
+
+// parseCSVRecord splits one raw record into its fields
+func parseCSVRecord(record string, comma rune) ([]string, error) {
+	r := csv.NewReader(strings.NewReader(record))
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	return r.Read()
+}
+
+// csvComma returns the delimiter rune for CSV mode, comma by default
+func csvComma(delim string) (rune, error) {
+	if delim == "" {
+		return ',', nil
+	}
+	if delim == `\t` {
+		return '\t', nil
+	}
+	comma, size := utf8.DecodeRuneInString(delim)
+	if size != len(delim) {
+		return 0, fmt.Errorf("csv delimiter must be a single character, got %q", delim)
+	}
+	return comma, nil
+}
+
+// csvColumnKey returns a keyFunc selecting the named column, looked up in
+// the header record
+func csvColumnKey(header, column string, comma rune) (keyFunc, error) {
+	names, err := parseCSVRecord(header, comma)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse csv header: %s", err)
+	}
+
+	idx := -1
+	for i, name := range names {
+		if strings.TrimSpace(name) == column {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, fmt.Errorf("csv column %q not found in header (have: %s)", column, strings.Join(names, ", "))
+	}
+
+	return func(record string) []string {
+		fields, err := parseCSVRecord(record, comma)
+		if err != nil || idx >= len(fields) {
+			return nil
+		}
+		return []string{fields[idx]}
+	}, nil
+}
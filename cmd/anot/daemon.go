@@ -0,0 +1,401 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// defaultSocketPath returns where the daemon listens unless -s is given:
+// the user's runtime directory, or a per-user name in the temp directory
+func defaultSocketPath() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, "anot.sock")
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("anot-%d.sock", os.Getuid()))
+}
+
+// runDaemon implements "anot daemon": the patterns are compiled once and
+// kept in memory, and every connection to the unix socket is a stream of
+// lines filtered against them, so tight loops of "anot client" runs never
+// pay for loading the patterns
+func runDaemon(args []string) error {
+	fs := flag.NewFlagSet("anot daemon", flag.ExitOnError)
+	var socketPath string
+	var compiledFile string
+	var patternFiles stringList
+	var asnDBFile string
+	var trim bool
+	var extended bool
+	var pprofAddr string
+	var reloadInterval time.Duration
+	var metricsAddr string
+	var controlPath string
+	var prof profiles
+	fs.StringVar(&socketPath, "s", defaultSocketPath(), "unix socket to listen on")
+	fs.StringVar(&controlPath, "control", "", "answer the commands of \"anot control\" on this unix socket")
+	fs.Var(&patternFiles, "p", "read patterns from this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	fs.StringVar(&asnDBFile, "asn-db", "", "ASN database for asn:NUMBER patterns")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.DurationVar(&reloadInterval, "reload-interval", 2*time.Second, "how often the pattern files are checked for changes to reload them (0 to reload only on SIGHUP)")
+	fs.StringVar(&metricsAddr, "metrics", "", "serve Prometheus metrics under /metrics on this address, e.g. localhost:9090")
+	fs.StringVar(&pprofAddr, "pprof", "", "serve the pprof endpoints under /debug/pprof/ on this address, e.g. localhost:6060")
+	fs.StringVar(&prof.cpu, "cpuprofile", "", "write a CPU profile to this file, until the daemon stops")
+	fs.StringVar(&prof.mem, "memprofile", "", "write a heap profile to this file when the daemon stops")
+	fs.StringVar(&prof.trace, "trace", "", "write an execution trace to this file, until the daemon stops")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot daemon [options] [-p patterns.txt...]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	// Pattern files given as arguments are the same as -p
+	patternFiles = append(patternFiles, fs.Args()...)
+
+	stopProfiling, err := prof.start()
+	if err != nil {
+		return ioError("", err)
+	}
+	defer func() {
+		if err := stopProfiling(); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to write profile: %s\n", err)
+		}
+	}()
+
+	var stats *metrics
+	if metricsAddr != "" {
+		stats = newMetrics()
+	}
+	src := &patternSource{who: "the daemon", compiledFile: compiledFile, patternFiles: patternFiles,
+		asnDBFile: asnDBFile, extended: extended, trim: trim, metrics: stats}
+	rules, matcher, err := src.load()
+	if err != nil {
+		return patternsError(err)
+	}
+	live := anot.NewLiveMatcher(matcher)
+
+	// Only the user running the daemon may talk to it
+	ln, err := listenUnix(socketPath, "a daemon")
+	if err != nil {
+		return ioError(socketPath, err)
+	}
+	d := &daemonControl{src: src, live: live, ln: ln, rules: rules, started: time.Now()}
+	stopped := make(chan struct{}, 1)
+	if controlPath != "" {
+		ctl, err := listenUnix(controlPath, "another anot")
+		if err != nil {
+			ln.Close()
+			return ioError(controlPath, err)
+		}
+		defer ctl.Close()
+		go serveControl(ctl, d, extended, trim, stopped)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		// Closing the listener removes the socket file
+		ln.Close()
+	}()
+
+	if metricsAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", stats)
+			if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: metrics endpoint failed: %s\n", err)
+			}
+		}()
+	}
+	if pprofAddr != "" {
+		go func() {
+			if err := servePprof(pprofAddr); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: pprof endpoint failed: %s\n", err)
+			}
+		}()
+	}
+
+	// Reloaded patterns apply to the next line of every connection
+	src.reloadOn(reloadInterval, d.replace)
+
+	fmt.Fprintf(os.Stderr, "listening on %s with %d patterns\n", socketPath, len(rules))
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			break
+		}
+		d.active.Add(1)
+		atomic.AddInt64(&d.connections, 1)
+		go func() {
+			defer d.active.Done()
+			defer atomic.AddInt64(&d.connections, -1)
+			serveLines(conn, live, trim, stats)
+			atomic.AddInt64(&d.served, 1)
+		}()
+	}
+	// The open connections finish their stream, whatever closed the listener
+	d.active.Wait()
+	if atomic.LoadInt32(&d.draining) != 0 {
+		// Stop once the drain command has its answer
+		<-stopped
+	}
+	return nil
+}
+
+// daemonControl is the state of a daemon its control socket works on
+type daemonControl struct {
+	src     *patternSource
+	live    *anot.LiveMatcher
+	ln      net.Listener
+	started time.Time
+
+	// mu guards rules, the patterns in use
+	mu    sync.Mutex
+	rules []*anot.Rule
+
+	active      sync.WaitGroup
+	connections int64
+	served      int64
+	draining    int32
+}
+
+// replace puts reloaded patterns to use
+func (d *daemonControl) replace(rules []*anot.Rule, m *anot.Matcher) {
+	d.mu.Lock()
+	d.rules = rules
+	d.live.Store(m)
+	d.mu.Unlock()
+}
+
+func (d *daemonControl) status() string {
+	d.mu.Lock()
+	patterns := len(d.rules)
+	d.mu.Unlock()
+	return fmt.Sprintf("patterns=%d connections=%d served=%d uptime=%s", patterns,
+		atomic.LoadInt64(&d.connections), atomic.LoadInt64(&d.served), time.Since(d.started).Truncate(time.Second))
+}
+
+func (d *daemonControl) reload() (int, error) {
+	rules, m, err := d.src.load()
+	if err != nil {
+		return 0, err
+	}
+	d.replace(rules, m)
+	fmt.Fprintf(os.Stderr, "reloaded %d patterns\n", len(rules))
+	return len(rules), nil
+}
+
+func (d *daemonControl) addPatterns(added []*anot.Rule) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	rules := append(append([]*anot.Rule(nil), d.rules...), added...)
+	m, err := d.src.compile(rules)
+	if err != nil {
+		return 0, err
+	}
+	d.rules = rules
+	d.live.Store(m)
+	return len(rules), nil
+}
+
+// drain stops taking connections and waits for those open to finish
+func (d *daemonControl) drain() {
+	atomic.StoreInt32(&d.draining, 1)
+	// Closing the listener removes the socket file
+	d.ln.Close()
+	d.active.Wait()
+}
+
+// loadResidentMatcher loads the rules of a long running mode, named by who
+// in errors, and compiles them, or takes both from a compiled file when no
+// further pattern files are given
+func loadResidentMatcher(who, compiledFile string, patternFiles []string, extended, trim bool) ([]*anot.Rule, *anot.Matcher, error) {
+	var rules []*anot.Rule
+	var compiled *anot.CompiledPatterns
+	if compiledFile != "" {
+		var err error
+		if compiled, err = readCompiled(compiledFile); err != nil {
+			return nil, nil, err
+		}
+		rules = compiled.StoredRules()
+	}
+	if len(patternFiles) > 0 || compiled == nil {
+		if len(patternFiles) == 0 {
+			// stdin of a long running mode is no place for the patterns
+			return nil, nil, fmt.Errorf("%s needs pattern files or -compiled", who)
+		}
+		fileRules, err := loadRules(patternFiles, extended, trim)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing pattern: %s", err)
+		}
+		rules = append(rules, fileRules...)
+	}
+
+	matched, whois := splitWhoisPatterns(rules)
+	if len(whois) > 0 {
+		return nil, nil, fmt.Errorf("%s does not support whois-org: patterns", who)
+	}
+	if compiled != nil && compiled.Hash == anot.RulesHash(matched) {
+		matcher, err := compiled.Matcher(matched)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", compiledFile, err)
+		}
+		return rules, matcher, nil
+	}
+	return rules, anot.NewMatcher(matched), nil
+}
+
+// trailerMark starts the line every answer of the daemon ends with, "ok" or
+// the error that cut the answer short, so the client never takes part of
+// an answer for all of it
+const trailerMark = "\x00"
+
+// serveLines filters the lines read from a client connection and writes
+// back the surviving ones, with the rule actions applied, as they come,
+// then the trailer
+func serveLines(conn net.Conn, live *anot.LiveMatcher, trim bool, stats *metrics) {
+	defer conn.Close()
+	start := time.Now()
+	trailer := "ok"
+	if err := anot.Filter(conn, conn, nil, anot.FilterOptions{Trim: trim, Live: live, Observe: stats.line}); err != nil {
+		trailer = "error: " + err.Error()
+	}
+	fmt.Fprintf(conn, "%s%s\n", trailerMark, trailer)
+	stats.observe("client", time.Since(start))
+}
+
+// readAnswer passes the lines of an answer of the daemon to emit, and
+// returns the error its trailer reports, or that there was none. The last
+// line is the trailer, so a line of the answer starting with the mark is
+// never mistaken for it.
+func readAnswer(conn io.Reader, emit func(line string)) error {
+	r := bufio.NewReader(conn)
+	var last string
+	have := false
+	for {
+		line, err := r.ReadString('\n')
+		if err == nil {
+			if have {
+				emit(last)
+			}
+			last, have = strings.TrimSuffix(line, "\n"), true
+			continue
+		}
+		// The daemon may close with input unread, so a reset can follow
+		// the trailer
+		if line == "" && have && strings.HasPrefix(last, trailerMark) {
+			status := strings.TrimPrefix(last, trailerMark)
+			if status == "ok" {
+				return nil
+			}
+			return fmt.Errorf("the daemon failed: %s", strings.TrimPrefix(status, "error: "))
+		}
+		if err == io.EOF {
+			return errors.New("the daemon's answer was cut short")
+		}
+		return err
+	}
+}
+
+// runClient implements "anot client": lines are filtered by a running
+// "anot daemon", either a file in place like anot does or stdin to stdout
+func runClient(args []string) error {
+	fs := flag.NewFlagSet("anot client", flag.ExitOnError)
+	var socketPath string
+	var quietMode bool
+	var dryRun bool
+	fs.StringVar(&socketPath, "s", defaultSocketPath(), "unix socket of the daemon")
+	fs.BoolVar(&quietMode, "q", false, "quiet mode (no output at all)")
+	fs.BoolVar(&dryRun, "d", false, "don't write to file, just print the filtered result to stdout")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot client [options] [filename]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return generalError(fmt.Errorf("no daemon running: %s", err))
+	}
+	defer conn.Close()
+
+	fn := fs.Arg(0)
+	if fn == "" {
+		// Stream stdin through the daemon, keeping memory flat
+		go func() {
+			io.Copy(conn, os.Stdin)
+			conn.(*net.UnixConn).CloseWrite()
+		}()
+		out := bufio.NewWriter(os.Stdout)
+		defer out.Flush()
+		r := bufio.NewReader(conn)
+		err := readAnswer(r, func(line string) {
+			if quietMode {
+				return
+			}
+			out.WriteString(line)
+			out.WriteByte('\n')
+			// Lines go on as soon as nothing more is waiting
+			if r.Buffered() == 0 {
+				out.Flush()
+			}
+		})
+		if err != nil {
+			return ioError("", err)
+		}
+		return nil
+	}
+
+	repaired, err := repairFromJournal(fn)
+	if err != nil {
+		return ioError(fn, fmt.Errorf("failed to repair from journal: %s", err))
+	}
+	if repaired {
+		fmt.Fprintf(os.Stderr, "warning: %s: completed an interrupted write from its journal\n", fn)
+	}
+	fileLines, err := readLines(fn)
+	if err != nil {
+		return ioError(fn, fmt.Errorf("failed to read file: %s", err))
+	}
+	go func() {
+		w := bufio.NewWriter(conn)
+		for _, line := range fileLines {
+			w.WriteString(line)
+			w.WriteByte('\n')
+		}
+		w.Flush()
+		conn.(*net.UnixConn).CloseWrite()
+	}()
+	var filteredLines []string
+	if err := readAnswer(conn, func(line string) { filteredLines = append(filteredLines, line) }); err != nil {
+		return ioError(fn, fmt.Errorf("%s, left unchanged", err))
+	}
+
+	if !quietMode {
+		for _, line := range filteredLines {
+			fmt.Println(line)
+		}
+	}
+	if !dryRun {
+		if err := writeLinesInPlace(fn, filteredLines, defaultWriteBuffer); err != nil {
+			return ioError(fn, fmt.Errorf("failed to write file: %s", err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// startDaemon serves the patterns on a unix socket in dir as "anot daemon"
+// does, and returns the socket
+func startDaemon(t *testing.T, dir, patterns string) string {
+	t.Helper()
+	rules, err := anot.ReadRules(strings.NewReader(patterns), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := anot.NewLiveMatcher(anot.NewMatcher(rules))
+	socket := filepath.Join(dir, "anot.sock")
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveLines(conn, live, false, nil)
+		}
+	}()
+	return socket
+}
+
+func TestClientFailure(t *testing.T) {
+	dir := t.TempDir()
+	socket := startDaemon(t, dir, "b\n")
+	long := strings.Repeat("x", anot.DefaultMaxLineSize+1)
+
+	if status, stderr := runAnot(t, dir, "a\nb\nc\n", "client", "-s", socket); status != 0 {
+		t.Errorf("exit status %d for a good stream; stderr:\n%s", status, stderr)
+	}
+	if status, _ := runAnot(t, dir, "a\n"+long+"\nc\n", "client", "-s", socket); status != 4 {
+		t.Errorf("exit status %d for a line too long, want 4", status)
+	}
+
+	// A file is left alone
+	fn := filepath.Join(dir, "list.txt")
+	content := "a\nb\n" + long + "\n"
+	if err := os.WriteFile(fn, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := runAnot(t, dir, "", "client", "-s", socket, fn); status != 4 {
+		t.Errorf("exit status %d for a file with a line too long, want 4", status)
+	}
+	if data, _ := os.ReadFile(fn); string(data) != content {
+		t.Error("the file was changed")
+	}
+}
+
+func TestReadAnswer(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer string
+		lines  []string
+		fails  bool
+	}{
+		{"ok", "a\nc\n" + trailerMark + "ok\n", []string{"a", "c"}, false},
+		{"mark in a line", trailerMark + "a\n" + trailerMark + "ok\n", []string{trailerMark + "a"}, false},
+		{"error", "a\n" + trailerMark + "error: token too long\n", []string{"a"}, true},
+		{"cut short", "a\nc\n", []string{"a"}, true},
+		{"nothing", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lines []string
+			err := readAnswer(strings.NewReader(tt.answer), func(line string) { lines = append(lines, line) })
+			if (err != nil) != tt.fails {
+				t.Errorf("err = %v", err)
+			}
+			if strings.Join(lines, "\n") != strings.Join(tt.lines, "\n") {
+				t.Errorf("lines %q, want %q", lines, tt.lines)
+			}
+		})
+	}
+}
+
+func TestListenUnixMode(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "anot.sock")
+	// A file left over by a process that died is replaced
+	if err := os.WriteFile(socket, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	ln, err := listenUnix(socket, "a daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	fi, err := os.Stat(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode()&os.ModeSocket == 0 || fi.Mode().Perm() != 0600 {
+		t.Errorf("socket mode %s, want 0600", fi.Mode())
+	}
+	if _, err := listenUnix(socket, "a daemon"); err == nil || !strings.Contains(err.Error(), "already listening") {
+		t.Errorf("err = %v for a socket in use", err)
+	}
+}
+
+func TestDaemonStopWaitsForConnections(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no SIGTERM")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "oos.txt"), []byte("b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	socket, control := filepath.Join(dir, "anot.sock"), filepath.Join(dir, "control.sock")
+	cmd := exec.Command(os.Args[0], "daemon", "-s", socket, "-control", control, "-reload-interval", "0", "-p", "oos.txt")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "ANOT_TEST_MAIN=1")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	defer cmd.Process.Kill()
+
+	var conn net.Conn
+	for i := 0; ; i++ {
+		var err error
+		if conn, err = net.Dial("unix", socket); err == nil {
+			break
+		}
+		if i == 100 {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	defer conn.Close()
+	if _, err := io.WriteString(conn, "a\nb\n"); err != nil {
+		t.Fatal(err)
+	}
+	// Connecting only queues the connection, so wait for the daemon to take it
+	for i := 0; ; i++ {
+		ctl, err := net.Dial("unix", control)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(ctl, "status\n")
+		reply, _ := bufio.NewReader(ctl).ReadString('\n')
+		ctl.Close()
+		if strings.Contains(reply, " connections=1 ") {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("status %q, want the connection open", reply)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// The daemon stops taking connections but finishes the open one
+	cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case err := <-done:
+		t.Fatalf("the daemon exited (%v) with a connection open", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	if _, err := io.WriteString(conn, "c\n"); err != nil {
+		t.Fatal(err)
+	}
+	conn.(*net.UnixConn).CloseWrite()
+	var lines []string
+	if err := readAnswer(conn, func(line string) { lines = append(lines, line) }); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(lines, " "); got != "a c" {
+		t.Errorf("answer %q, want \"a c\"", got)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("the daemon exited with %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the daemon did not exit once the connection was done")
+	}
+}
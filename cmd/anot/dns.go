@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// dnsAnswer is the cached outcome of resolving one name, failures included
+// so a name that does not resolve is only asked about once (negative cache)
+type dnsAnswer struct {
+	addrs []net.IP
+	err   error
+	// notFound is set when every attempt answered NXDOMAIN
+	notFound bool
+}
+
+// dnsClient resolves names with a timeout per query and caches every answer
+type dnsClient struct {
+	resolver *net.Resolver
+	timeout  time.Duration
+	// retries is the number of extra attempts after a failed lookup
+	retries int
+	// upstreams replace the system resolver when configured
+	upstreams []*upstream
+	turn      uint64
+	// cache persists definite answers across runs, when enabled
+	cache *diskCache
+
+	mu     sync.Mutex
+	hosts  map[string]*dnsAnswer
+	cnames map[string]*cnameAnswer
+	ptrs   map[string]*ptrAnswer
+}
+
+// ptrAnswer is the cached reverse DNS names of an address
+type ptrAnswer struct {
+	names []string
+	err   error
+}
+
+// cnameAnswer is the cached canonical name a name's CNAME chain ends at
+type cnameAnswer struct {
+	target string
+	err    error
+}
+
+// cachedLookup is the persisted form of a DNS answer. Only definite
+// answers are persisted, names and addresses or NXDOMAIN, never timeouts.
+type cachedLookup struct {
+	Values   []string `json:"values,omitempty"`
+	NotFound bool     `json:"nxdomain,omitempty"`
+}
+
+// notFoundError recreates the NXDOMAIN error of a cached answer
+func notFoundError(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+// newDNSClient returns a client using the system resolver
+func newDNSClient(timeout time.Duration) *dnsClient {
+	return &dnsClient{
+		resolver: net.DefaultResolver,
+		timeout:  timeout,
+		hosts:    make(map[string]*dnsAnswer),
+		cnames:   make(map[string]*cnameAnswer),
+		ptrs:     make(map[string]*ptrAnswer),
+	}
+}
+
+// lookupIP resolves a name to its addresses
+func (c *dnsClient) lookupIP(ctx context.Context, name string) ([]net.IP, error) {
+	answer := c.lookupHost(ctx, name)
+	return answer.addrs, answer.err
+}
+
+// lookupHost resolves a name and returns the full, cached answer
+func (c *dnsClient) lookupHost(ctx context.Context, name string) *dnsAnswer {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	c.mu.Lock()
+	answer, ok := c.hosts[name]
+	c.mu.Unlock()
+	if ok {
+		return answer
+	}
+	var cached cachedLookup
+	if c.cache.get("host:"+name, &cached) {
+		answer = &dnsAnswer{notFound: cached.NotFound}
+		if cached.NotFound {
+			answer.err = notFoundError(name)
+		}
+		for _, v := range cached.Values {
+			answer.addrs = append(answer.addrs, net.ParseIP(v))
+		}
+		c.mu.Lock()
+		c.hosts[name] = answer
+		c.mu.Unlock()
+		return answer
+	}
+
+	// Retry failures, NXDOMAIN included, so a single flaky or lying
+	// resolver cannot get a live name pruned. A lookup cut short by the
+	// context answers nothing, so it is neither NXDOMAIN nor cached.
+	answer = &dnsAnswer{err: ctx.Err()}
+	notFound := true
+	attempts := 0
+	for ; attempts <= c.retries && ctx.Err() == nil; attempts++ {
+		lookupCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		addrs, err := c.next(lookupCtx).LookupIPAddr(lookupCtx, name)
+		cancel()
+		if err == nil {
+			answer = &dnsAnswer{}
+			for _, a := range addrs {
+				answer.addrs = append(answer.addrs, a.IP)
+			}
+			break
+		}
+		answer.err = err
+		if !isNotFound(err) {
+			notFound = false
+		}
+	}
+	if answer.err != nil && ctx.Err() != nil {
+		return &dnsAnswer{err: ctx.Err()}
+	}
+	answer.notFound = answer.err != nil && notFound && attempts > c.retries
+
+	if answer.err == nil || answer.notFound {
+		cached := cachedLookup{NotFound: answer.notFound}
+		for _, ip := range answer.addrs {
+			cached.Values = append(cached.Values, ip.String())
+		}
+		c.cache.put("host:"+name, cached)
+	}
+
+	c.mu.Lock()
+	c.hosts[name] = answer
+	c.mu.Unlock()
+	return answer
+}
+
+// isNotFound reports whether a lookup error is an NXDOMAIN answer
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// lookupCNAME follows the CNAME chain of a name and returns the canonical
+// name it terminates at, without the trailing dot
+func (c *dnsClient) lookupCNAME(ctx context.Context, name string) (string, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	c.mu.Lock()
+	answer, ok := c.cnames[name]
+	c.mu.Unlock()
+	if ok {
+		return answer.target, answer.err
+	}
+
+	var cached cachedLookup
+	if c.cache.get("cname:"+name, &cached) {
+		answer = &cnameAnswer{}
+		if cached.NotFound {
+			answer.err = notFoundError(name)
+		} else if len(cached.Values) > 0 {
+			answer.target = cached.Values[0]
+		}
+	} else {
+		lookupCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		target, err := c.next(lookupCtx).LookupCNAME(lookupCtx, name)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		answer = &cnameAnswer{target: strings.TrimSuffix(strings.ToLower(target), "."), err: err}
+		if err == nil {
+			c.cache.put("cname:"+name, cachedLookup{Values: []string{answer.target}})
+		} else if isNotFound(err) {
+			c.cache.put("cname:"+name, cachedLookup{NotFound: true})
+		}
+	}
+
+	c.mu.Lock()
+	c.cnames[name] = answer
+	c.mu.Unlock()
+	return answer.target, answer.err
+}
+
+// lookupAddr returns the PTR names of an address, without trailing dots
+func (c *dnsClient) lookupAddr(ctx context.Context, addr string) ([]string, error) {
+	c.mu.Lock()
+	answer, ok := c.ptrs[addr]
+	c.mu.Unlock()
+	if ok {
+		return answer.names, answer.err
+	}
+
+	var cached cachedLookup
+	if c.cache.get("ptr:"+addr, &cached) {
+		answer = &ptrAnswer{names: cached.Values}
+		if cached.NotFound {
+			answer.err = notFoundError(addr)
+		}
+	} else {
+		lookupCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		names, err := c.next(lookupCtx).LookupAddr(lookupCtx, addr)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		answer = &ptrAnswer{err: err}
+		for _, name := range names {
+			answer.names = append(answer.names, strings.TrimSuffix(strings.ToLower(name), "."))
+		}
+		if err == nil || isNotFound(err) {
+			c.cache.put("ptr:"+addr, cachedLookup{Values: answer.names, NotFound: err != nil})
+		}
+	}
+
+	c.mu.Lock()
+	c.ptrs[addr] = answer
+	c.mu.Unlock()
+	return answer.names, answer.err
+}
+
+// resolveCheck removes domains when any address they resolve to matches
+// the IP and CIDR patterns, or when their CNAME chain terminates at a name
+// matched by the patterns (e.g. *.cloudfront.net)
+type resolveCheck struct {
+	dns     *dnsClient
+	matcher *anot.Matcher
+}
+
+func (r *resolveCheck) wants(key string) bool {
+	return looksLikeDomain(key) && !strings.HasPrefix(key, "*.")
+}
+
+func (r *resolveCheck) check(ctx context.Context, key string) *anot.Rule {
+	if target, err := r.dns.lookupCNAME(ctx, key); err == nil && target != "" && target != strings.ToLower(key) {
+		if rule := r.matcher.Match(target); rule != nil {
+			return rule
+		}
+	}
+
+	addrs, err := r.dns.lookupIP(ctx, key)
+	if err != nil {
+		return nil
+	}
+	for _, ip := range addrs {
+		if rule := r.matcher.Match(ip.String()); rule != nil {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ptrCheck removes IP addresses whose reverse DNS name matches the
+// patterns, e.g. *.amazonaws.com
+type ptrCheck struct {
+	dns     *dnsClient
+	matcher *anot.Matcher
+}
+
+func (p *ptrCheck) wants(key string) bool {
+	return net.ParseIP(key) != nil
+}
+
+func (p *ptrCheck) check(ctx context.Context, key string) *anot.Rule {
+	names, err := p.dns.lookupAddr(ctx, key)
+	if err != nil {
+		return nil
+	}
+	for _, name := range names {
+		if rule := p.matcher.Match(name); rule != nil {
+			return rule
+		}
+	}
+	return nil
+}
+
+// deadRule is the rule reported for names pruned by --prune-dead
+var deadRule = &anot.Rule{Pattern: "NXDOMAIN", Action: anot.ActionRemove}
+
+// pruneCheck removes domains that do not exist, i.e. whose every lookup
+// attempt answered NXDOMAIN. Timeouts and server failures keep the line.
+type pruneCheck struct {
+	dns *dnsClient
+}
+
+func (p *pruneCheck) wants(key string) bool {
+	return looksLikeDomain(key) && !strings.HasPrefix(key, "*.")
+}
+
+func (p *pruneCheck) check(ctx context.Context, key string) *anot.Rule {
+	if answer := p.dns.lookupHost(ctx, key); answer.err != nil && answer.notFound {
+		return deadRule
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLookupCancelled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	cache, err := openCache(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newDNSClient(time.Second)
+	c.cache = cache
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	answer := c.lookupHost(ctx, "example.com")
+	if answer.notFound {
+		t.Error("a cancelled lookup answered NXDOMAIN")
+	}
+	if !errors.Is(answer.err, context.Canceled) {
+		t.Errorf("err = %v, want %v", answer.err, context.Canceled)
+	}
+	if _, err := c.lookupCNAME(ctx, "example.com"); !errors.Is(err, context.Canceled) {
+		t.Errorf("CNAME err = %v, want %v", err, context.Canceled)
+	}
+	if _, err := c.lookupAddr(ctx, "192.0.2.1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("PTR err = %v, want %v", err, context.Canceled)
+	}
+
+	if len(c.hosts) != 0 || len(c.cnames) != 0 || len(c.ptrs) != 0 {
+		t.Error("a cancelled lookup was remembered for the run")
+	}
+	var cached cachedLookup
+	for _, key := range []string{"host:example.com", "cname:example.com", "ptr:192.0.2.1"} {
+		if cache.get(key, &cached) {
+			t.Errorf("a cancelled lookup was cached as %s", key)
+		}
+	}
+	if err := cache.save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("a cancelled lookup was saved to the cache file")
+	}
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// finding is one result of "anot doctor", with what to do about it unless
+// it is fine
+type finding struct {
+	level string
+	what  string
+	fix   string
+}
+
+// doctorCase is a line of the built-in corpus, which must be removed by
+// the corpus patterns or kept
+type doctorCase struct {
+	line    string
+	removed bool
+}
+
+// doctorPatterns and doctorCorpus check the matching semantics every pattern
+// type relies on
+var doctorPatterns = []string{
+	"a.example.com",
+	"*.example.org",
+	"10.0.0.0/8",
+	"2001:db8::/32",
+	"00:1a:2b:3c:4d:5e",
+	"00:1A:2C:*",
+	"192.0.2.0/33",
+}
+
+var doctorCorpus = []doctorCase{
+	{"a.example.com", true},
+	{"b.example.com", false},
+	{"x.example.org", true},
+	{"x.y.example.org", true},
+	{"badexample.org", false},
+	{"10.1.2.3", true},
+	{"11.0.0.1", false},
+	{"2001:db8::1", true},
+	{"2001:db9::1", false},
+	{"00-1A-2B-3C-4D-5E", true},
+	{"00:1a:2c:00:00:01", true},
+	{"00:1a:2d:00:00:01", false},
+	// Not a valid CIDR, so only the pattern itself matches
+	{"192.0.2.0/33", true},
+	{"192.0.2.1", false},
+}
+
+// runDoctor implements "anot doctor": the pattern sources, databases and
+// caches a run would use are checked, and a built-in corpus is run through
+// the matcher, every finding saying what to do about it. It exits 1 when
+// anything would make a run fail.
+func runDoctor(args []string) {
+	fs := flag.NewFlagSet("anot doctor", flag.ExitOnError)
+	var patternFiles stringList
+	var compiledFile string
+	var server string
+	var serverToken string
+	var asnDBFile string
+	var cacheFile string
+	var trim bool
+	var extended bool
+	var timeout time.Duration
+	fs.Var(&patternFiles, "p", "check the patterns of this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "check the patterns compiled by \"anot compile\" in this file")
+	fs.StringVar(&server, "server", "", "check the \"anot serve\" at this URL and its cached pattern set")
+	fs.StringVar(&serverToken, "server-token", os.Getenv("ANOT_TOKEN"), "bearer token for -server (default $ANOT_TOKEN)")
+	fs.StringVar(&asnDBFile, "asn-db", "", "check this ASN database")
+	fs.StringVar(&cacheFile, "cache-file", "", "lookup cache to check (default: anot/cache.json in the user cache directory)")
+	fs.BoolVar(&trim, "t", false, "the patterns are used with -t")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.DurationVar(&timeout, "timeout", 10*time.Second, "how long to wait for -server")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot doctor [-p patterns.txt...] [-compiled scope.anotc] [-server URL] [options]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() > 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	var findings []finding
+	report := func(f ...finding) {
+		findings = append(findings, f...)
+	}
+
+	report(checkCorpus()...)
+	var rules []*anot.Rule
+	for _, fn := range patternFiles {
+		fileRules, f := checkPatternFile(fn, extended, trim)
+		rules = append(rules, fileRules...)
+		report(f...)
+	}
+	if compiledFile != "" {
+		compiledRules, f := checkCompiledFile(compiledFile)
+		rules = append(rules, compiledRules...)
+		report(f...)
+	}
+	if server != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		report(checkServer(ctx, server, serverToken)...)
+		cancel()
+	}
+	report(checkASNDatabase(asnDBFile, rules)...)
+	report(checkCaches(cacheFile)...)
+
+	failed := false
+	for _, f := range findings {
+		fmt.Printf("%-8s %s\n", f.level, f.what)
+		if f.fix != "" {
+			fmt.Printf("%-8s -> %s\n", "", f.fix)
+		}
+		failed = failed || f.level == "error"
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func okFinding(format string, args ...interface{}) finding {
+	return finding{level: "ok", what: fmt.Sprintf(format, args...)}
+}
+
+// checkCorpus runs the built-in corpus through a matcher of the corpus
+// patterns, built directly and loaded back from its compiled form
+func checkCorpus() []finding {
+	rules := make([]*anot.Rule, len(doctorPatterns))
+	for i, pattern := range doctorPatterns {
+		rules[i] = &anot.Rule{Pattern: pattern}
+	}
+	built := anot.NewMatcher(rules)
+	loaded, err := anot.Compile(rules, built).Matcher(rules)
+	if err != nil {
+		return []finding{{"error", fmt.Sprintf("self-test: the compiled matcher doesn't load: %s", err),
+			"this build of anot is broken, reinstall it"}}
+	}
+
+	var findings []finding
+	for _, m := range []struct {
+		name    string
+		matcher *anot.Matcher
+	}{{"matcher", built}, {"compiled matcher", loaded}} {
+		for _, c := range doctorCorpus {
+			if removed := m.matcher.Match(c.line) != nil; removed != c.removed {
+				want := "kept"
+				if c.removed {
+					want = "removed"
+				}
+				findings = append(findings, finding{"error", fmt.Sprintf("self-test: the %s doesn't leave %q %s", m.name, c.line, want),
+					"this build of anot is broken, reinstall it"})
+			}
+		}
+	}
+	if len(findings) == 0 {
+		findings = append(findings, okFinding("self-test: %d lines matched as expected, compiled or not", len(doctorCorpus)))
+	}
+	return findings
+}
+
+// checkPatternFile reads a pattern file as a run would and looks for
+// patterns that can't match what they seem to
+func checkPatternFile(fn string, extended, trim bool) ([]*anot.Rule, []finding) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, []finding{{"error", fmt.Sprintf("%s: %s", fn, err), "fix the path given with -p"}}
+	}
+	defer f.Close()
+
+	var rules []*anot.Rule
+	var findings []finding
+	warn := func(n int, what, fix string) {
+		findings = append(findings, finding{"warning", fmt.Sprintf("%s:%d: %s", fn, n, what), fix})
+	}
+	seen := make(map[string]int)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		var rule *anot.Rule
+		if extended {
+			if rule, err = anot.ParseExtendedRule(line); err != nil {
+				findings = append(findings, finding{"error", fmt.Sprintf("%s:%d: %s", fn, n, err), "fix the pattern, runs with this file fail"})
+				continue
+			}
+			if rule == nil {
+				continue
+			}
+		} else {
+			if trim {
+				line = strings.TrimSpace(line)
+			}
+			rule = &anot.Rule{Pattern: line}
+		}
+		rules = append(rules, rule)
+
+		pattern := rule.Pattern
+		switch {
+		case pattern == "":
+			warn(n, "blank pattern, which removes every blank line", "delete the line, or use -x, which skips blank lines")
+		case !extended && strings.HasPrefix(pattern, "#"):
+			warn(n, fmt.Sprintf("%q is a pattern, not a comment", pattern), "use -x, where # starts a comment")
+		case strings.TrimSpace(pattern) != pattern:
+			warn(n, fmt.Sprintf("%q has leading or trailing whitespace, so only lines with the same match", pattern),
+				"trim the pattern, or run with -t")
+		case strings.HasPrefix(pattern, "*.") && strings.Contains(pattern[2:], "*"):
+			warn(n, fmt.Sprintf("%q has a * past its first label, which matches literally", pattern),
+				"only leading *. wildcards are supported")
+		case strings.Contains(pattern, "/") && looksLikeCIDR(pattern):
+			if _, err := netip.ParsePrefix(pattern); err != nil {
+				warn(n, fmt.Sprintf("%q is not a valid CIDR range, so it only matches itself", pattern),
+					"fix the address or prefix length")
+			}
+		}
+		if first, ok := seen[pattern]; ok {
+			warn(n, fmt.Sprintf("%q repeats line %d, which wins", pattern, first), "delete one of them")
+		} else {
+			seen[pattern] = n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, append(findings, finding{"error", fmt.Sprintf("%s: %s", fn, err), "runs with this file fail"})
+	}
+	if len(rules) == 0 {
+		findings = append(findings, finding{"warning", fmt.Sprintf("%s: no patterns, nothing is removed", fn), ""})
+	} else {
+		findings = append(findings, okFinding("%s: %d patterns", fn, len(rules)))
+	}
+	return rules, findings
+}
+
+// looksLikeCIDR reports whether a pattern with a slash looks meant as an
+// address range rather than, say, a path
+func looksLikeCIDR(pattern string) bool {
+	addr, bits, _ := strings.Cut(pattern, "/")
+	if _, err := strconv.Atoi(bits); err != nil {
+		return false
+	}
+	return strings.Trim(addr, "0123456789abcdefABCDEF.:") == ""
+}
+
+// checkCompiledFile loads a compiled pattern file, verifying its version
+// and that its rules are the ones it was compiled from
+func checkCompiledFile(fn string) ([]*anot.Rule, []finding) {
+	c, err := readCompiled(fn)
+	if err != nil {
+		return nil, []finding{{"error", err.Error(), "compile it again with anot compile"}}
+	}
+	if c.Version != anot.CompiledVersion {
+		return nil, []finding{{"error", fmt.Sprintf("%s: compiled with format version %d, this anot reads %d", fn, c.Version, anot.CompiledVersion),
+			"compile it again with this version of anot"}}
+	}
+	rules := c.StoredRules()
+	matched, _ := splitWhoisPatterns(rules)
+	if c.Hash != anot.RulesHash(matched) {
+		return nil, []finding{{"error", fmt.Sprintf("%s: the rules don't match the hash they were compiled with", fn),
+			"the file is damaged, compile it again"}}
+	}
+	if _, err := c.Matcher(matched); err != nil {
+		return nil, []finding{{"error", fmt.Sprintf("%s: %s", fn, err), "the file is damaged, compile it again"}}
+	}
+	return rules, []finding{okFinding("%s: %d compiled patterns", fn, len(rules))}
+}
+
+// checkServer fetches the pattern set of an anot server, comparing it with
+// the copy cached by the last run
+func checkServer(ctx context.Context, server, token string) []finding {
+	path, err := serverCachePath(server)
+	if err != nil {
+		return []finding{{"error", fmt.Sprintf("%s: %s", server, err), "set $XDG_CACHE_HOME or $HOME"}}
+	}
+	cached, _ := readCompiled(path)
+	var age string
+	if info, err := os.Stat(path); err == nil && cached != nil {
+		age = time.Since(info.ModTime()).Round(time.Second).String()
+	}
+
+	fresh, err := fetchCompiled(ctx, server, token, cached)
+	switch {
+	case err != nil && cached == nil:
+		return []finding{{"error", fmt.Sprintf("%s: %s, and no copy is cached", server, err),
+			"check the URL and -server-token; runs with -server fail until it answers"}}
+	case err != nil:
+		return []finding{{"warning", fmt.Sprintf("%s: %s, runs use the copy cached %s ago", server, err, age),
+			"check the URL and -server-token"}}
+	case fresh == cached:
+		return []finding{okFinding("%s: reachable, the cached copy is current", server)}
+	}
+	if cached != nil {
+		return []finding{okFinding("%s: reachable, %d patterns, newer than the copy cached %s ago", server, len(fresh.Rules), age)}
+	}
+	return []finding{okFinding("%s: reachable, %d patterns", server, len(fresh.Rules))}
+}
+
+// checkASNDatabase opens the ASN database, which asn: patterns need
+func checkASNDatabase(fn string, rules []*anot.Rule) []finding {
+	asn := 0
+	for _, rule := range rules {
+		if anot.PatternType(rule.Pattern) == "asn" {
+			asn++
+		}
+	}
+	if fn == "" {
+		if asn > 0 {
+			return []finding{{"error", fmt.Sprintf("%d asn: patterns but no ASN database", asn), "pass one with -asn-db"}}
+		}
+		return nil
+	}
+	if _, err := openASNDatabase(fn); err != nil {
+		return []finding{{"error", fmt.Sprintf("%s: %s", fn, err), "fix the path given with -asn-db, or download the database again"}}
+	}
+	return []finding{okFinding("%s: ASN database readable", fn)}
+}
+
+// checkCaches checks the lookup cache and looks for compiled pattern sets
+// cached by other versions of anot
+func checkCaches(cacheFile string) []finding {
+	var findings []finding
+	if cacheFile == "" {
+		var err error
+		if cacheFile, err = defaultCachePath(); err != nil {
+			return []finding{{"warning", fmt.Sprintf("no user cache directory: %s", err), "set $XDG_CACHE_HOME or $HOME to use -cache and -compile-cache"}}
+		}
+	}
+	data, err := os.ReadFile(cacheFile)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		findings = append(findings, finding{"error", fmt.Sprintf("%s: %s", cacheFile, err), "fix its permissions or delete it; runs with -cache fail"})
+	default:
+		var entries map[string]cacheEntry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			findings = append(findings, finding{"warning", fmt.Sprintf("%s: corrupt lookup cache, runs start it over", cacheFile), "delete it"})
+			break
+		}
+		expired, now := 0, time.Now().Unix()
+		for _, entry := range entries {
+			if entry.Expires <= now {
+				expired++
+			}
+		}
+		findings = append(findings, okFinding("%s: %d cached lookups, %d expired", cacheFile, len(entries), expired))
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return findings
+	}
+	dir = filepath.Join(dir, "anot")
+	suffix := ".v" + strconv.Itoa(anot.CompiledVersion)
+	for _, sub := range []string{"compiled", "server"} {
+		entries, err := os.ReadDir(filepath.Join(dir, sub))
+		if err != nil {
+			continue
+		}
+		stale := 0
+		var size int64
+		for _, entry := range entries {
+			if strings.HasSuffix(entry.Name(), suffix) {
+				continue
+			}
+			stale++
+			if info, err := entry.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		if stale > 0 {
+			findings = append(findings, finding{"warning",
+				fmt.Sprintf("%s: %d pattern sets cached by other versions of anot, %d bytes", filepath.Join(dir, sub), stale, size),
+				"delete the files not ending in " + suffix})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// dohTimeout bounds a DoH request when the resolver sets no deadline
+const dohTimeout = 10 * time.Second
+
+var dohClient = &http.Client{}
+
+// dohConn carries the stream (TCP framed) DNS exchanges of the Go resolver
+// over DNS-over-HTTPS (RFC 8484): every complete query written is POSTed
+// as application/dns-message and the answer is handed back on Read
+type dohConn struct {
+	url      string
+	deadline time.Time
+	out      bytes.Buffer
+	in       bytes.Buffer
+}
+
+func newDoHConn(url string) *dohConn {
+	return &dohConn{url: url}
+}
+
+func (c *dohConn) Write(b []byte) (int, error) {
+	c.out.Write(b)
+	for c.out.Len() >= 2 {
+		frame := c.out.Bytes()
+		n := int(frame[0])<<8 | int(frame[1])
+		if len(frame) < 2+n {
+			break
+		}
+		answer, err := c.exchange(frame[2 : 2+n])
+		c.out.Next(2 + n)
+		if err != nil {
+			return 0, err
+		}
+		c.in.WriteByte(byte(len(answer) >> 8))
+		c.in.WriteByte(byte(len(answer)))
+		c.in.Write(answer)
+	}
+	return len(b), nil
+}
+
+// exchange sends one DNS message to the DoH endpoint and returns the answer
+func (c *dohConn) exchange(msg []byte) ([]byte, error) {
+	deadline := c.deadline
+	if deadline.IsZero() {
+		deadline = time.Now().Add(dohTimeout)
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(msg))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+
+	resp, err := dohClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DoH %s: %s", c.url, resp.Status)
+	}
+	answer, err := io.ReadAll(io.LimitReader(resp.Body, 65535+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(answer) > 65535 {
+		return nil, errors.New("DoH answer too large")
+	}
+	return answer, nil
+}
+
+func (c *dohConn) Read(b []byte) (int, error) {
+	if c.in.Len() == 0 {
+		return 0, io.EOF
+	}
+	return c.in.Read(b)
+}
+
+func (c *dohConn) Close() error                       { return nil }
+func (c *dohConn) LocalAddr() net.Addr                { return dohAddr{} }
+func (c *dohConn) RemoteAddr() net.Addr               { return dohAddr{} }
+func (c *dohConn) SetDeadline(t time.Time) error      { c.deadline = t; return nil }
+func (c *dohConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *dohConn) SetWriteDeadline(t time.Time) error { c.deadline = t; return nil }
+
+// dohAddr is the placeholder address of a dohConn
+type dohAddr struct{}
+
+func (dohAddr) Network() string { return "https" }
+func (dohAddr) String() string  { return "doh" }
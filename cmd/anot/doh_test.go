@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// dohAnswer answers a DNS query with one A record for every A question and
+// no records otherwise
+func dohAnswer(query []byte, ip net.IP) []byte {
+	// Header (12 bytes), then the question: QNAME labels, QTYPE, QCLASS
+	end := 12
+	for query[end] != 0 {
+		end += 1 + int(query[end])
+	}
+	end += 5
+	qtype := int(query[end-4])<<8 | int(query[end-3])
+
+	answer := append([]byte{}, query[:end]...)
+	answer[2], answer[3] = 0x81, 0x80 // response, recursion desired and available
+	answer[6], answer[7] = 0, 0       // ANCOUNT
+	answer[8], answer[9] = 0, 0       // NSCOUNT
+	answer[10], answer[11] = 0, 0     // ARCOUNT
+	if qtype == 1 {
+		answer[7] = 1
+		answer = append(answer, 0xc0, 12, 0, 1, 0, 1, 0, 0, 0, 60, 0, 4)
+		answer = append(answer, ip.To4()...)
+	}
+	return answer
+}
+
+func TestDoHConn(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   []string
+		fails  bool
+	}{
+		{"answer", http.StatusOK, []string{"192.0.2.7"}, false},
+		{"server error", http.StatusServiceUnavailable, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/dns-message" {
+					t.Errorf("got %s with Content-Type %q", r.Method, r.Header.Get("Content-Type"))
+				}
+				query, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				if tt.status != http.StatusOK {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/dns-message")
+				w.Write(dohAnswer(query, net.IPv4(192, 0, 2, 7)))
+			}))
+			defer srv.Close()
+
+			resolver := &net.Resolver{
+				PreferGo: true,
+				Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+					return newDoHConn(srv.URL), nil
+				},
+			}
+			addrs, err := resolver.LookupHost(context.Background(), "doh.example.com")
+			if (err != nil) != tt.fails {
+				t.Fatalf("err = %v, want failure %v", err, tt.fails)
+			}
+			if !reflect.DeepEqual(addrs, tt.want) {
+				t.Errorf("addrs = %q, want %q", addrs, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoHConnPartialWrite(t *testing.T) {
+	var posted [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		posted = append(posted, body)
+		w.Write([]byte("answer"))
+	}))
+	defer srv.Close()
+
+	// A frame split over two writes is sent once it is complete, and two
+	// frames in one write are sent separately
+	c := newDoHConn(srv.URL)
+	for _, b := range [][]byte{{0, 3, 'a'}, {'b', 'c', 0, 1, 'd'}} {
+		if n, err := c.Write(b); err != nil || n != len(b) {
+			t.Fatalf("Write = %d, %v", n, err)
+		}
+	}
+	if want := [][]byte{[]byte("abc"), []byte("d")}; !reflect.DeepEqual(posted, want) {
+		t.Errorf("posted %q, want %q", posted, want)
+	}
+	got, _ := io.ReadAll(c)
+	if want := "\x00\x06answer\x00\x06answer"; string(got) != want {
+		t.Errorf("read %q, want %q", got, want)
+	}
+}
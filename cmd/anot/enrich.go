@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// Enricher looks up metadata about an IP address or a domain, such as the
+// open ports or owner of a host, so that patterns can match on it
+type Enricher interface {
+	// Enrich returns the known fields of key, each possibly multi-valued.
+	// Keys the provider knows nothing about yield no fields and no error.
+	Enrich(ctx context.Context, key string) (map[string][]string, error)
+}
+
+// enricherFactory builds a registered enricher
+type enricherFactory func() (Enricher, error)
+
+// enrichers holds the built-in providers, keyed by name
+var enrichers = make(map[string]enricherFactory)
+
+// registerEnricher adds a built-in provider, called from init functions
+func registerEnricher(name string, factory enricherFactory) {
+	if _, ok := enrichers[name]; ok {
+		panic("duplicate enricher " + name)
+	}
+	enrichers[name] = factory
+}
+
+// newEnricher builds an enricher from an --enrich spec: the name of a
+// built-in provider, or NAME=URL for any HTTP endpoint answering JSON
+// objects, where {} in the URL is replaced by the looked up key
+func newEnricher(spec string) (string, Enricher, error) {
+	if name, endpoint, ok := strings.Cut(spec, "="); ok {
+		if !strings.Contains(endpoint, "{}") {
+			return "", nil, fmt.Errorf("enricher URL %q has no {} placeholder", endpoint)
+		}
+		return name, &jsonEnricher{url: endpoint}, nil
+	}
+
+	factory, ok := enrichers[spec]
+	if !ok {
+		names := make([]string, 0, len(enrichers))
+		for name := range enrichers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", nil, fmt.Errorf("unknown enricher %q (want %s or NAME=URL)", spec, strings.Join(names, ", "))
+	}
+	e, err := factory()
+	return spec, e, err
+}
+
+// enrichTimeout bounds a single enrichment request
+const enrichTimeout = 10 * time.Second
+
+var enrichClient = &http.Client{Timeout: enrichTimeout}
+
+// jsonEnricher queries an HTTP endpoint answering one JSON object per key;
+// nested objects become dotted field names and arrays multiple values
+type jsonEnricher struct {
+	url string
+	// ipOnly skips domains for providers that only know addresses
+	ipOnly bool
+	header http.Header
+}
+
+func (j *jsonEnricher) Enrich(ctx context.Context, key string) (map[string][]string, error) {
+	if j.ipOnly && net.ParseIP(key) == nil {
+		return nil, nil
+	}
+
+	netRate.wait(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(j.url, "{}", url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range j.header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := enrichClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", req.URL.Host, resp.Status)
+	}
+
+	var doc interface{}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	fields := make(map[string][]string)
+	flattenJSON("", doc, fields)
+	return fields, nil
+}
+
+// flattenJSON collects the scalars of a JSON document by dotted path
+func flattenJSON(prefix string, v interface{}, fields map[string][]string) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			flattenJSON(name, child, fields)
+		}
+	case []interface{}:
+		for _, child := range t {
+			flattenJSON(prefix, child, fields)
+		}
+	default:
+		if prefix != "" {
+			fields[prefix] = jsonScalars([]interface{}{v}, fields[prefix])
+		}
+	}
+}
+
+// enrichPredicate is a provider.field:value pattern
+type enrichPredicate struct {
+	provider string
+	field    string
+	value    string
+	rule     *anot.Rule
+}
+
+// splitPredicates takes the provider.field:value patterns of the given
+// providers out of the rules. Other patterns, IPv6 addresses included,
+// are left alone since their prefix names no provider.
+func splitPredicates(rules []*anot.Rule, providers map[string]Enricher) ([]*anot.Rule, []*enrichPredicate) {
+	var rest []*anot.Rule
+	var preds []*enrichPredicate
+	for _, rule := range rules {
+		left, value, ok := strings.Cut(rule.Pattern, ":")
+		provider, field, dotted := strings.Cut(left, ".")
+		if !ok || !dotted || field == "" || providers[provider] == nil {
+			rest = append(rest, rule)
+			continue
+		}
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = value[1 : len(value)-1]
+		}
+		preds = append(preds, &enrichPredicate{provider: provider, field: field, value: value, rule: rule})
+	}
+	return rest, preds
+}
+
+// enrichCheck removes IPs and domains whose enrichment fields match a
+// predicate, comparing values case-insensitively
+type enrichCheck struct {
+	providers map[string]Enricher
+	preds     []*enrichPredicate
+	cache     *diskCache
+}
+
+func (e *enrichCheck) wants(key string) bool {
+	return net.ParseIP(key) != nil || (looksLikeDomain(key) && !strings.HasPrefix(key, "*."))
+}
+
+func (e *enrichCheck) check(ctx context.Context, key string) *anot.Rule {
+	fetched := make(map[string]map[string][]string)
+	for _, p := range e.preds {
+		fields, ok := fetched[p.provider]
+		if !ok {
+			fields = e.fields(ctx, p.provider, key)
+			fetched[p.provider] = fields
+		}
+		for _, v := range fields[p.field] {
+			if strings.EqualFold(v, p.value) {
+				return p.rule
+			}
+		}
+	}
+	return nil
+}
+
+// fields returns the enrichment of a key by one provider, cached across
+// runs when the cache is enabled. Failed lookups keep the line.
+func (e *enrichCheck) fields(ctx context.Context, provider, key string) map[string][]string {
+	cacheKey := "enrich:" + provider + ":" + key
+	var fields map[string][]string
+	if e.cache.get(cacheKey, &fields) {
+		return fields
+	}
+	fields, err := e.providers[provider].Enrich(ctx, key)
+	if err != nil {
+		return nil
+	}
+	if fields == nil {
+		fields = map[string][]string{}
+	}
+	e.cache.put(cacheKey, fields)
+	return fields
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"net/http"
+	"os"
+)
+
+func init() {
+	// Shodan InternetDB: ports, hostnames, tags, cpes and vulns, no key needed
+	registerEnricher("internetdb", func() (Enricher, error) {
+		return &jsonEnricher{url: "https://internetdb.shodan.io/{}", ipOnly: true}, nil
+	})
+
+	// ipinfo.io: hostname, city, region, country, org...; the token is
+	// taken from IPINFO_TOKEN when set
+	registerEnricher("ipinfo", func() (Enricher, error) {
+		e := &jsonEnricher{url: "https://ipinfo.io/{}/json", ipOnly: true}
+		if token := os.Getenv("IPINFO_TOKEN"); token != "" {
+			e.header = http.Header{"Authorization": {"Bearer " + token}}
+		}
+		return e, nil
+	})
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// errorKind tells the errors wrapping scripts react to differently apart;
+// each kind has its own exit status
+type errorKind int
+
+const (
+	// errGeneral is any error of no other kind
+	errGeneral errorKind = iota
+	// errUsage is a bad option or combination of options
+	errUsage
+	// errPatterns is a pattern file, compiled file or other pattern
+	// source that can't be used, be it missing or malformed
+	errPatterns
+	// errIO is a target or database that can't be read or written
+	errIO
+	// errGuard is a run a safety option such as --offline refuses
+	errGuard
+	// errTimeout is a run stopped by its --timeout
+	errTimeout
+	// errInterrupted is a run stopped by SIGINT or SIGTERM, with the
+	// status of a shell's interrupted command
+	errInterrupted
+)
+
+var errorKinds = [...]struct {
+	name string
+	exit int
+}{
+	errGeneral:     {"error", 1},
+	errUsage:       {"usage", 2},
+	errPatterns:    {"patterns", 3},
+	errIO:          {"io", 4},
+	errGuard:       {"guard", 5},
+	errTimeout:     {"timeout", 6},
+	errInterrupted: {"interrupted", 130},
+}
+
+// kindError is an error of a known kind, about file if it is about one
+type kindError struct {
+	kind errorKind
+	file string
+	err  error
+}
+
+func (e *kindError) Error() string {
+	if e.file != "" {
+		return e.file + ": " + e.err.Error()
+	}
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() error {
+	return e.err
+}
+
+func usageError(format string, args ...interface{}) error {
+	return &kindError{kind: errUsage, err: fmt.Errorf(format, args...)}
+}
+
+func guardError(format string, args ...interface{}) error {
+	return &kindError{kind: errGuard, err: fmt.Errorf(format, args...)}
+}
+
+func ioError(file string, err error) error {
+	return &kindError{kind: errIO, file: file, err: err}
+}
+
+// fileError is the error of a target file, an I/O error unless it has a
+// kind already
+func fileError(file string, err error) error {
+	var e *kindError
+	if errors.As(err, &e) && e.file == "" {
+		return &kindError{kind: e.kind, file: file, err: e.err}
+	}
+	return ioError(file, err)
+}
+
+// stoppedError is the error of the work ctx stopped, saying what became of
+// it, or nil while the work goes on
+func stoppedError(ctx context.Context, outcome string) error {
+	if ctx == nil || ctx.Err() == nil {
+		return nil
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return &kindError{kind: errTimeout, err: errors.New("stopped by --timeout, " + outcome)}
+	}
+	return &kindError{kind: errInterrupted, err: errors.New("interrupted, " + outcome)}
+}
+
+func patternsError(err error) error {
+	return &kindError{kind: errPatterns, err: err}
+}
+
+func generalError(err error) error {
+	return &kindError{kind: errGeneral, err: err}
+}
+
+func kindOf(err error) errorKind {
+	var e *kindError
+	if errors.As(err, &e) {
+		return e.kind
+	}
+	return errGeneral
+}
+
+// exitStatus returns the exit status of an error, 0 for none
+func exitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	return errorKinds[kindOf(err)].exit
+}
+
+// exitOn ends a subcommand that failed: its error is reported and anot
+// exits with the status of its kind
+func exitOn(err error) {
+	if err == nil {
+		return
+	}
+	writeError(os.Stderr, err)
+	os.Exit(exitStatus(err))
+}
+
+// jsonErrors makes errors print as JSON objects, set by --json-errors
+var jsonErrors bool
+
+// writeError prints an error as "error: ..." or, with --json-errors, as
+// a JSON object on a line of its own
+func writeError(w io.Writer, err error) {
+	if !jsonErrors {
+		fmt.Fprintf(w, "error: %s\n", err)
+		return
+	}
+	kind := kindOf(err)
+	report := struct {
+		Error    string `json:"error"`
+		Kind     string `json:"kind"`
+		File     string `json:"file,omitempty"`
+		ExitCode int    `json:"exit_code"`
+	}{Error: err.Error(), Kind: errorKinds[kind].name, ExitCode: errorKinds[kind].exit}
+	var e *kindError
+	if errors.As(err, &e) {
+		report.File = e.file
+		if e.file != "" {
+			report.Error = e.err.Error()
+		}
+	}
+	line, _ := json.Marshal(report)
+	fmt.Fprintf(w, "%s\n", line)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSubcommandExitStatus(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "list.txt"), []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"compile without -o", []string{"compile", "missing.txt"}, 2},
+		{"compile missing patterns", []string{"compile", "-o", "out.anotc", "missing.txt"}, 3},
+		{"compile missing -p patterns", []string{"compile", "-o", "out.anotc", "-p", "missing.txt"}, 3},
+		{"sync without -p", []string{"sync", "list.txt"}, 2},
+		{"sync missing patterns", []string{"sync", "-p", "missing.txt", "list.txt"}, 3},
+		{"serve missing patterns", []string{"serve", "-listen", "localhost:0", "missing.txt"}, 3},
+		{"serve missing -p patterns", []string{"serve", "-listen", "localhost:0", "-p", "missing.txt"}, 3},
+		{"daemon missing patterns", []string{"daemon", "-s", filepath.Join(dir, "d.sock"), "missing.txt"}, 3},
+		{"daemon missing -p patterns", []string{"daemon", "-s", filepath.Join(dir, "d.sock"), "-p", "missing.txt"}, 3},
+		{"client without daemon", []string{"client", "-s", filepath.Join(dir, "none.sock")}, 1},
+		{"watch bad interval", []string{"watch", "-interval", "0", "list.txt"}, 2},
+		{"watch missing patterns", []string{"watch", "-p", "missing.txt", "list.txt"}, 3},
+		{"cron bad schedule", []string{"cron", "61 * * * *", "list.txt"}, 2},
+		{"cron without patterns", []string{"cron", "@hourly", "-q", "list.txt"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, stderr := runAnot(t, dir, "", tt.args...)
+			if status != tt.want {
+				t.Errorf("exit status %d, want %d; stderr:\n%s", status, tt.want, stderr)
+			}
+		})
+	}
+}
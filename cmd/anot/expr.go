@@ -0,0 +1,518 @@
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+type exprType int
+
+const (
+	exprString exprType = iota
+	exprInt
+	exprBool
+)
+
+func (t exprType) String() string {
+	return [...]string{"string", "int", "bool"}[t]
+}
+
+// exprNode is a compiled subexpression, its value computed from the line
+// by the function of its type. Literals also keep their value, for the
+// arguments that are compiled once, such as regular expressions.
+type exprNode struct {
+	typ exprType
+	s   func(string) string
+	n   func(string) int
+	b   func(string) bool
+	lit *string
+}
+
+// exprFunc is a function of the language; compile builds its node from
+// the argument nodes, whose types are checked beforehand
+type exprFunc struct {
+	args    []exprType
+	compile func(args []*exprNode) (*exprNode, error)
+}
+
+func strBool(f func(a, b string) bool) exprFunc {
+	return exprFunc{[]exprType{exprString, exprString}, func(args []*exprNode) (*exprNode, error) {
+		a, b := args[0].s, args[1].s
+		return &exprNode{typ: exprBool, b: func(l string) bool { return f(a(l), b(l)) }}, nil
+	}}
+}
+
+func strPred(f func(string) bool) exprFunc {
+	return exprFunc{[]exprType{exprString}, func(args []*exprNode) (*exprNode, error) {
+		a := args[0].s
+		return &exprNode{typ: exprBool, b: func(l string) bool { return f(a(l)) }}, nil
+	}}
+}
+
+func strMap(f func(string) string) exprFunc {
+	return exprFunc{[]exprType{exprString}, func(args []*exprNode) (*exprNode, error) {
+		a := args[0].s
+		return &exprNode{typ: exprString, s: func(l string) string { return f(a(l)) }}, nil
+	}}
+}
+
+func strStr(f func(a, b string) string) exprFunc {
+	return exprFunc{[]exprType{exprString, exprString}, func(args []*exprNode) (*exprNode, error) {
+		a, b := args[0].s, args[1].s
+		return &exprNode{typ: exprString, s: func(l string) string { return f(a(l), b(l)) }}, nil
+	}}
+}
+
+var exprFuncs = map[string]exprFunc{
+	"startsWith": strBool(strings.HasPrefix),
+	"endsWith":   strBool(strings.HasSuffix),
+	"contains":   strBool(strings.Contains),
+	"lower":      strMap(strings.ToLower),
+	"upper":      strMap(strings.ToUpper),
+	"trim":       strMap(strings.TrimSpace),
+	"trimPrefix": strStr(strings.TrimPrefix),
+	"trimSuffix": strStr(strings.TrimSuffix),
+	"replace": {[]exprType{exprString, exprString, exprString}, func(args []*exprNode) (*exprNode, error) {
+		a, old, repl := args[0].s, args[1].s, args[2].s
+		return &exprNode{typ: exprString, s: func(l string) string { return strings.ReplaceAll(a(l), old(l), repl(l)) }}, nil
+	}},
+	"host": strMap(hostFromToken),
+	"apex": strMap(apexDomain),
+	"tld": strMap(func(s string) string {
+		s = strings.TrimSuffix(s, ".")
+		return s[strings.LastIndex(s, ".")+1:]
+	}),
+	"is_ip":     strPred(isIPAddr),
+	"is_ipv4":   strPred(func(s string) bool { a, err := netip.ParseAddr(s); return err == nil && a.Is4() }),
+	"is_ipv6":   strPred(func(s string) bool { a, err := netip.ParseAddr(s); return err == nil && a.Is6() }),
+	"is_domain": strPred(looksLikeDomain),
+	"size": {[]exprType{exprString}, func(args []*exprNode) (*exprNode, error) {
+		a := args[0].s
+		return &exprNode{typ: exprInt, n: func(l string) int { return len(a(l)) }}, nil
+	}},
+	"field": {[]exprType{exprString, exprInt}, func(args []*exprNode) (*exprNode, error) {
+		a, i := args[0].s, args[1].n
+		return &exprNode{typ: exprString, s: func(l string) string {
+			fields := strings.Fields(a(l))
+			if n := i(l); n >= 1 && n <= len(fields) {
+				return fields[n-1]
+			}
+			return ""
+		}}, nil
+	}},
+	"matches": {[]exprType{exprString, exprString}, func(args []*exprNode) (*exprNode, error) {
+		if args[1].lit == nil {
+			return nil, fmt.Errorf("matches needs a string literal as the regular expression")
+		}
+		re, err := regexp.Compile(*args[1].lit)
+		if err != nil {
+			return nil, err
+		}
+		a := args[0].s
+		return &exprNode{typ: exprBool, b: func(l string) bool { return re.MatchString(a(l)) }}, nil
+	}},
+	"ip_in": {[]exprType{exprString, exprString}, func(args []*exprNode) (*exprNode, error) {
+		if args[1].lit == nil {
+			return nil, fmt.Errorf("ip_in needs a string literal as the CIDR range")
+		}
+		prefix, err := netip.ParsePrefix(*args[1].lit)
+		if err != nil {
+			return nil, err
+		}
+		prefix = prefix.Masked()
+		a := args[0].s
+		return &exprNode{typ: exprBool, b: func(l string) bool {
+			addr, err := netip.ParseAddr(a(l))
+			return err == nil && prefix.Contains(addr.Unmap())
+		}}, nil
+	}},
+}
+
+// parseExpr compiles an --expr predicate, which must be boolean: lines it
+// holds for are removed. The language is a small subset of CEL over the
+// value matched, named line, with strings, integers and booleans:
+//
+//	line.endsWith(".int") || ip_in(line, "10.0.0.0/8")
+//	!is_ip(line) && size(field(line, 2)) > 40
+//
+// Functions can be called as f(x, ...) or x.f(...); see exprFuncs.
+func parseExpr(src string) (*anot.Expr, error) {
+	node, err := compileExpr(src, exprBool)
+	if err != nil {
+		return nil, err
+	}
+	return &anot.Expr{Eval: node.b, Rule: &anot.Rule{Pattern: "expr:" + src, Action: anot.ActionRemove}}, nil
+}
+
+// parseNormalize parses a --normalize expression, of type string
+func parseNormalize(src string) (func(string) string, error) {
+	node, err := compileExpr(src, exprString)
+	if err != nil {
+		return nil, err
+	}
+	return node.s, nil
+}
+
+func compileExpr(src string, want exprType) (*exprNode, error) {
+	toks, err := lexExpr(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %s", src, err)
+	}
+	p := &exprParser{toks: toks}
+	node, err := p.or()
+	if err == nil && p.peek().kind != tokEOF {
+		err = fmt.Errorf("unexpected %s", p.peek())
+	}
+	if err == nil && node.typ != want {
+		err = fmt.Errorf("the expression is of type %s, not %s", node.typ, want)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %s", src, err)
+	}
+	return node, nil
+}
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokIdent
+	tokString
+	tokInt
+	tokOp
+)
+
+type exprToken struct {
+	kind tokKind
+	text string
+}
+
+func (t exprToken) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+var exprOps = []string{"||", "&&", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", ",", "."}
+
+func lexExpr(src string) ([]exprToken, error) {
+	var toks []exprToken
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			toks = append(toks, exprToken{tokString, unescapeExpr(src[i+1 : j])})
+			i = j + 1
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && src[j] >= '0' && src[j] <= '9' {
+				j++
+			}
+			toks = append(toks, exprToken{tokInt, src[i:j]})
+			i = j
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] >= 'a' && src[j] <= 'z' || src[j] >= 'A' && src[j] <= 'Z' || src[j] >= '0' && src[j] <= '9') {
+				j++
+			}
+			toks = append(toks, exprToken{tokIdent, src[i:j]})
+			i = j
+		default:
+			op := ""
+			for _, o := range exprOps {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q", c)
+			}
+			toks = append(toks, exprToken{tokOp, op})
+			i += len(op)
+		}
+	}
+	return append(toks, exprToken{kind: tokEOF}), nil
+}
+
+// unescapeExpr resolves the \\, \", \', \n and \t escapes of a string
+// literal; other backslashes stay, as regular expressions need them
+func unescapeExpr(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			switch s[i+1] {
+			case '\\', '"', '\'':
+				i++
+			case 'n':
+				b.WriteByte('\n')
+				i++
+				continue
+			case 't':
+				b.WriteByte('\t')
+				i++
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+type exprParser struct {
+	toks []exprToken
+	pos  int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.toks[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *exprParser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokOp && t.text == op
+}
+
+func (p *exprParser) expect(op string) error {
+	if !p.isOp(op) {
+		return fmt.Errorf("expected %q, found %s", op, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *exprParser) or() (*exprNode, error) {
+	left, err := p.and()
+	for err == nil && p.isOp("||") {
+		p.next()
+		var right *exprNode
+		if right, err = p.and(); err == nil {
+			left, err = logical("||", left, right)
+		}
+	}
+	return left, err
+}
+
+func (p *exprParser) and() (*exprNode, error) {
+	left, err := p.unary()
+	for err == nil && p.isOp("&&") {
+		p.next()
+		var right *exprNode
+		if right, err = p.unary(); err == nil {
+			left, err = logical("&&", left, right)
+		}
+	}
+	return left, err
+}
+
+func logical(op string, left, right *exprNode) (*exprNode, error) {
+	if left.typ != exprBool || right.typ != exprBool {
+		return nil, fmt.Errorf("%s needs bool operands, not %s and %s", op, left.typ, right.typ)
+	}
+	a, b := left.b, right.b
+	if op == "||" {
+		return &exprNode{typ: exprBool, b: func(l string) bool { return a(l) || b(l) }}, nil
+	}
+	return &exprNode{typ: exprBool, b: func(l string) bool { return a(l) && b(l) }}, nil
+}
+
+func (p *exprParser) unary() (*exprNode, error) {
+	if !p.isOp("!") {
+		return p.comparison()
+	}
+	p.next()
+	operand, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if operand.typ != exprBool {
+		return nil, fmt.Errorf("! needs a bool operand, not %s", operand.typ)
+	}
+	a := operand.b
+	return &exprNode{typ: exprBool, b: func(l string) bool { return !a(l) }}, nil
+}
+
+func (p *exprParser) comparison() (*exprNode, error) {
+	left, err := p.postfix()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if t.kind != tokOp {
+		return left, nil
+	}
+	op := t.text
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return left, nil
+	}
+	p.next()
+	right, err := p.postfix()
+	if err != nil {
+		return nil, err
+	}
+	if left.typ != right.typ {
+		return nil, fmt.Errorf("%s compares %s with %s", op, left.typ, right.typ)
+	}
+
+	var cmp func(string) int
+	switch left.typ {
+	case exprString:
+		a, b := left.s, right.s
+		cmp = func(l string) int { return strings.Compare(a(l), b(l)) }
+	case exprInt:
+		a, b := left.n, right.n
+		cmp = func(l string) int {
+			x, y := a(l), b(l)
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	case exprBool:
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("%s can't compare bools", op)
+		}
+		a, b := left.b, right.b
+		cmp = func(l string) int {
+			if a(l) == b(l) {
+				return 0
+			}
+			return 1
+		}
+	}
+	var test func(int) bool
+	switch op {
+	case "==":
+		test = func(c int) bool { return c == 0 }
+	case "!=":
+		test = func(c int) bool { return c != 0 }
+	case "<":
+		test = func(c int) bool { return c < 0 }
+	case "<=":
+		test = func(c int) bool { return c <= 0 }
+	case ">":
+		test = func(c int) bool { return c > 0 }
+	case ">=":
+		test = func(c int) bool { return c >= 0 }
+	}
+	return &exprNode{typ: exprBool, b: func(l string) bool { return test(cmp(l)) }}, nil
+}
+
+// postfix parses a primary followed by method calls, x.f(args)
+func (p *exprParser) postfix() (*exprNode, error) {
+	node, err := p.primary()
+	for err == nil && p.isOp(".") {
+		p.next()
+		name := p.next()
+		if name.kind != tokIdent {
+			return nil, fmt.Errorf("expected a method name, found %s", name)
+		}
+		node, err = p.call(name.text, []*exprNode{node})
+	}
+	return node, err
+}
+
+func (p *exprParser) primary() (*exprNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		s := t.text
+		return &exprNode{typ: exprString, s: func(string) string { return s }, lit: &s}, nil
+	case tokInt:
+		n, err := strconv.Atoi(t.text)
+		if err != nil {
+			return nil, fmt.Errorf("bad number %s", t.text)
+		}
+		return &exprNode{typ: exprInt, n: func(string) int { return n }}, nil
+	case tokIdent:
+		switch t.text {
+		case "line":
+			return &exprNode{typ: exprString, s: func(l string) string { return l }}, nil
+		case "true", "false":
+			v := t.text == "true"
+			return &exprNode{typ: exprBool, b: func(string) bool { return v }}, nil
+		}
+		if p.isOp("(") {
+			return p.call(t.text, nil)
+		}
+		return nil, fmt.Errorf("unknown name %q", t.text)
+	case tokOp:
+		if t.text == "(" {
+			node, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s", t)
+}
+
+// call parses the arguments of a call to name, after the receiver of a
+// method call if any, and compiles it
+func (p *exprParser) call(name string, args []*exprNode) (*exprNode, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for given := 0; !p.isOp(")"); given++ {
+		if given > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	p.next()
+
+	f, ok := exprFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	if len(args) != len(f.args) {
+		return nil, fmt.Errorf("%s takes %d arguments, not %d", name, len(f.args), len(args))
+	}
+	for i, arg := range args {
+		if arg.typ != f.args[i] {
+			return nil, fmt.Errorf("argument %d of %s must be of type %s, not %s", i+1, name, f.args[i], arg.typ)
+		}
+	}
+	return f.compile(args)
+}
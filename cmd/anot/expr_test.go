@@ -0,0 +1,110 @@
+package main
+
+import "testing"
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		expr string
+		line string
+		want bool
+	}{
+		{`line.endsWith(".int") || ip_in(line, "10.0.0.0/8")`, "corp.int", true},
+		{`line.endsWith(".int") || ip_in(line, "10.0.0.0/8")`, "10.1.2.3", true},
+		{`line.endsWith(".int") || ip_in(line, "10.0.0.0/8")`, "11.1.2.3", false},
+		{`ip_in(line, "10.1.2.3/8")`, "10.200.0.1", true},
+		{`ip_in(line, "10.0.0.0/8")`, "::ffff:10.0.0.1", true},
+		{`ip_in(line, "10.0.0.0/8")`, "example.com", false},
+		{`!is_ip(line) && size(field(line, 2)) > 4`, "a hello", true},
+		{`!is_ip(line) && size(field(line, 2)) > 4`, "a hi", false},
+		{`!is_ip(line) && size(field(line, 2)) > 4`, "a", false},
+		{`is_ipv4(line)`, "192.0.2.1", true},
+		{`is_ipv4(line)`, "2001:db8::1", false},
+		{`is_ipv6(line)`, "2001:db8::1", true},
+		{`startsWith(line, "www.")`, "www.example.com", true},
+		{`line.lower().contains("test")`, "A.TEST.com", true},
+		{`line.trim() == "x"`, "  x\t", true},
+		{`line.trimPrefix("www.").trimSuffix(".com") == "example"`, "www.example.com", true},
+		{`line.replace("-", ".") == "a.b.c"`, "a-b-c", true},
+		{`upper(line) == "ABC"`, "abc", true},
+		{`tld(line) == "org"`, "example.org.", true},
+		{`line.matches("^[0-9]+$")`, "12345", true},
+		{`line.matches("^[0-9]+$")`, "123a", false},
+		{`line.matches('\d\.\d')`, "1.2", true},
+		{`line == "say \"hi\""`, `say "hi"`, true},
+		{`line < "b"`, "a", true},
+		{`line >= "b"`, "a", false},
+		{`size(line) <= 3 && size(line) != 2`, "abc", true},
+		{`is_ip(line) == false`, "example.com", true},
+		{`true && !(false || line == "")`, "x", true},
+		// && binds tighter than ||
+		{`true || false && false`, "", true},
+		{`!true || true`, "", true},
+	}
+	for _, test := range tests {
+		e, err := parseExpr(test.expr)
+		if err != nil {
+			t.Errorf("parseExpr(%s): %s", test.expr, err)
+			continue
+		}
+		if got := e.Eval(test.line); got != test.want {
+			t.Errorf("%s on %q = %t, want %t", test.expr, test.line, got, test.want)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`line`,
+		`size(line)`,
+		`line == 1`,
+		`line && true`,
+		`!line`,
+		`true < false`,
+		`unknown(line)`,
+		`nothing`,
+		`endsWith(line)`,
+		`size(1)`,
+		`line.matches(lower("x"))`,
+		`line.matches("(")`,
+		`ip_in(line, "10.0.0.0")`,
+		`ip_in(line, line)`,
+		`line == "open`,
+		`(true`,
+		`true true`,
+		`line.1()`,
+		`line # 1`,
+		`size(line,`,
+	} {
+		if _, err := parseExpr(expr); err == nil {
+			t.Errorf("parseExpr(%s) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestParseNormalize(t *testing.T) {
+	tests := []struct {
+		expr string
+		line string
+		want string
+	}{
+		{`line.lower()`, "Example.COM", "example.com"},
+		{`field(line, 2)`, "1.2.3.4 example.com", "example.com"},
+		{`field(line, 3)`, "1.2.3.4 example.com", ""},
+		{`line.trimPrefix("*.")`, "*.example.com", "example.com"},
+		{`"tab\there"`, "", "tab\there"},
+	}
+	for _, test := range tests {
+		normalize, err := parseNormalize(test.expr)
+		if err != nil {
+			t.Errorf("parseNormalize(%s): %s", test.expr, err)
+			continue
+		}
+		if got := normalize(test.line); got != test.want {
+			t.Errorf("%s on %q = %q, want %q", test.expr, test.line, got, test.want)
+		}
+	}
+	if _, err := parseNormalize(`is_ip(line)`); err == nil {
+		t.Error("parseNormalize of a bool expression succeeded, want an error")
+	}
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// keyFunc extracts the values of a line that are matched against the
+// patterns; the line is removed when any of them matches
+type keyFunc func(line string) []string
+
+// fieldKey returns a keyFunc selecting the n-th (1-based) field of a line.
+// An empty delimiter splits on runs of whitespace, like awk.
+func fieldKey(n int, delim string) keyFunc {
+	return func(line string) []string {
+		var fields []string
+		if delim == "" {
+			fields = strings.Fields(line)
+		} else {
+			fields = strings.Split(line, delim)
+		}
+		if n < 1 || n > len(fields) {
+			return nil
+		}
+		return []string{fields[n-1]}
+	}
+}
+
+// jsonStep is one step of a parsed JSON key path
+type jsonStep struct {
+	key   string
+	index int  // array index when isIdx is set
+	isIdx bool // [N]
+	all   bool // [] iterates over every array element
+}
+
+// parseJSONPath parses a basic jq-style path such as .host, .a.b, .a[0],
+// .a[] or .["odd.key"]
+func parseJSONPath(path string) ([]jsonStep, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("json key path %q must start with '.'", path)
+	}
+
+	var steps []jsonStep
+	rest := path
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("json key path %q: missing ']'", path)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			switch {
+			case inner == "":
+				steps = append(steps, jsonStep{all: true})
+			case strings.HasPrefix(inner, `"`):
+				key, err := strconv.Unquote(inner)
+				if err != nil {
+					return nil, fmt.Errorf("json key path %q: bad quoted key %s", path, inner)
+				}
+				steps = append(steps, jsonStep{key: key})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("json key path %q: bad index %q", path, inner)
+				}
+				steps = append(steps, jsonStep{index: n, isIdx: true})
+			}
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end > 0 {
+				steps = append(steps, jsonStep{key: rest[:end]})
+			}
+			rest = rest[end:]
+		default:
+			return nil, fmt.Errorf("json key path %q: unexpected %q", path, rest)
+		}
+	}
+	return steps, nil
+}
+
+// jsonKey returns a keyFunc extracting the value at a key path from JSON
+// lines. Arrays of scalars yield one key per element, and lines that are
+// not JSON objects yield no keys at all.
+func jsonKey(steps []jsonStep) keyFunc {
+	return func(line string) []string {
+		var root interface{}
+		dec := json.NewDecoder(strings.NewReader(line))
+		dec.UseNumber()
+		if err := dec.Decode(&root); err != nil {
+			return nil
+		}
+		return jsonScalars(jsonLookup(root, steps), nil)
+	}
+}
+
+// jsonLookup walks the path and returns every value it reaches
+func jsonLookup(root interface{}, steps []jsonStep) []interface{} {
+	values := []interface{}{root}
+	for _, step := range steps {
+		var next []interface{}
+		for _, v := range values {
+			switch {
+			case step.all:
+				if arr, ok := v.([]interface{}); ok {
+					next = append(next, arr...)
+				}
+			case step.isIdx:
+				if arr, ok := v.([]interface{}); ok {
+					i := step.index
+					if i < 0 {
+						i += len(arr)
+					}
+					if i >= 0 && i < len(arr) {
+						next = append(next, arr[i])
+					}
+				}
+			default:
+				if obj, ok := v.(map[string]interface{}); ok {
+					if child, ok := obj[step.key]; ok {
+						next = append(next, child)
+					}
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// jsonScalars flattens values to strings, descending into arrays
+func jsonScalars(values []interface{}, out []string) []string {
+	for _, v := range values {
+		switch t := v.(type) {
+		case string:
+			out = append(out, t)
+		case json.Number:
+			out = append(out, t.String())
+		case bool:
+			out = append(out, strconv.FormatBool(t))
+		case []interface{}:
+			out = jsonScalars(t, out)
+		}
+	}
+	return out
+}
+
+// extractKey returns a keyFunc pulling a single token out of heterogeneous
+// lines such as URLs, host:port pairs or access log entries:
+//
+//	host    the host of the first token (URL, host:port or bare value)
+//	ip      the first token anywhere on the line that is an IP address
+//	domain  the first token anywhere on the line that looks like a domain
+func extractKey(kind string) (keyFunc, error) {
+	switch kind {
+	case "host":
+		return func(line string) []string {
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				return nil
+			}
+			if host := hostFromToken(fields[0]); host != "" {
+				return []string{host}
+			}
+			return nil
+		}, nil
+	case "ip":
+		return func(line string) []string {
+			for _, tok := range lineTokens(line) {
+				if host := hostFromToken(tok); isIPAddr(host) {
+					return []string{host}
+				}
+			}
+			return nil
+		}, nil
+	case "domain":
+		return func(line string) []string {
+			for _, tok := range lineTokens(line) {
+				if host := hostFromToken(tok); looksLikeDomain(host) {
+					return []string{host}
+				}
+			}
+			return nil
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown extract kind %q (want host, ip or domain)", kind)
+}
+
+// lineTokens splits a line on whitespace and the punctuation that usually
+// surrounds hosts in logs and reports
+func lineTokens(line string) []string {
+	return strings.FieldsFunc(line, func(r rune) bool {
+		switch r {
+		case ' ', '\t', '"', '\'', '<', '>', '(', ')', '{', '}', ',', ';', '|', '=':
+			return true
+		}
+		return false
+	})
+}
+
+// hostFromToken returns the host part of a URL, host:port, [v6]:port or
+// host/path token, or the token itself when it has none of those shapes
+func hostFromToken(tok string) string {
+	if strings.Contains(tok, "://") {
+		if u, err := url.Parse(tok); err == nil {
+			return u.Hostname()
+		}
+	}
+	if i := strings.IndexAny(tok, "/?#"); i >= 0 {
+		tok = tok[:i]
+	}
+	if strings.HasPrefix(tok, "[") {
+		if end := strings.Index(tok, "]"); end > 0 {
+			return tok[1:end]
+		}
+		return strings.Trim(tok, "[]")
+	}
+	if isIPAddr(tok) {
+		return tok
+	}
+	if host, _, err := net.SplitHostPort(tok); err == nil {
+		return host
+	}
+	return strings.Trim(tok, "[].:")
+}
+
+// isIPAddr reports whether s is a plain IPv4 or IPv6 address, parsed with
+// netip so the per-line checks don't allocate
+func isIPAddr(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Zone() == ""
+}
+
+// looksLikeDomain reports whether s is shaped like a DNS name with at
+// least two labels, and is not an IP address
+func looksLikeDomain(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 || !strings.Contains(s, ".") {
+		return false
+	}
+	letters := false
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+				letters = true
+			case c >= '0' && c <= '9', c == '-', c == '_', c == '*' && i == 0:
+			default:
+				return false
+			}
+		}
+	}
+	return letters
+}
+
+// emailKeys wraps a keyFunc so the domain part of keys that are email
+// addresses is matched too, e.g. *.example.com removes bob@mail.example.com
+func emailKeys(keys keyFunc) keyFunc {
+	return func(line string) []string {
+		base := keys(line)
+		out := base
+		for _, key := range base {
+			if domain := emailDomain(key); domain != "" {
+				out = append(out, domain)
+			}
+		}
+		return out
+	}
+}
+
+// wrapKeys adds the values -email, -url and -ignore-zones match besides
+// the keys of a line, nil for the whole line
+func (j *filterJob) wrapKeys(keys keyFunc) keyFunc {
+	if !j.emailMode && !j.urlMode && !j.ignoreZones {
+		return keys
+	}
+	if keys == nil {
+		keys = wholeLine
+	}
+	if j.emailMode {
+		keys = emailKeys(keys)
+	}
+	if j.clean != nil && (j.urlMode || j.ignoreZones) {
+		keys = cleanKeys(keys, j.clean)
+	}
+	if j.urlMode {
+		keys = urlKeys(keys, j.anyScheme)
+	}
+	if j.ignoreZones {
+		keys = zoneKeys(keys)
+	}
+	return keys
+}
+
+// cleanKeys wraps a keyFunc so the keys are cleaned up
+func cleanKeys(keys keyFunc, clean func(string) string) keyFunc {
+	return func(line string) []string {
+		lineKeys := keys(line)
+		for i := range lineKeys {
+			lineKeys[i] = clean(lineKeys[i])
+		}
+		return lineKeys
+	}
+}
+
+// zoneKeys wraps a keyFunc so keys that are IPv6 addresses are matched
+// without their zone, in their canonical form, too: fe80::/10 removes
+// fe80::1%eth0, and fe80::1 removes fe80:0::1 as it does fe80:0::1%eth0
+func zoneKeys(keys keyFunc) keyFunc {
+	return func(line string) []string {
+		base := keys(line)
+		out := base
+		for _, key := range base {
+			key = strings.TrimSpace(key)
+			addr, err := netip.ParseAddr(key)
+			if err != nil || !addr.Is6() {
+				continue
+			}
+			if unzoned := addr.WithZone("").String(); unzoned != key {
+				out = append(out, unzoned)
+			}
+		}
+		return out
+	}
+}
+
+// emailDomain returns the domain of an email address, accepting the
+// "mailto:" and "<...>" wrappers, or "" if s is not an address
+func emailDomain(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.LastIndex(s, "<"); i >= 0 && strings.HasSuffix(s, ">") {
+		s = s[i+1 : len(s)-1]
+	}
+	s = strings.TrimPrefix(s, "mailto:")
+	at := strings.LastIndex(s, "@")
+	if at <= 0 || strings.ContainsAny(s, " \t") {
+		return ""
+	}
+	domain := strings.ToLower(s[at+1:])
+	if !looksLikeDomain(domain) {
+		return ""
+	}
+	return domain
+}
+
+// wholeLine is the default keyFunc, matching the line itself
+func wholeLine(line string) []string {
+	return []string{line}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIgnoreZones(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"zoned and unzoned", []string{"--ignore-zones"}, "fe80::2%eth0\n2001:db8::1\n"},
+		{"zones kept", nil, "fe80:0::1\nfe80:0::1%eth0\nfe80::2%eth0\nfe80::1%eth1\n2001:db8::1\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			list := "fe80::1\nfe80:0::1\nfe80:0::1%eth0\nfe80::2%eth0\nfe80::1%eth1\n2001:db8::1\n"
+			for fn, data := range map[string]string{"oos.txt": "fe80::1\n", "list.txt": list} {
+				if err := os.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			args := append(append([]string(nil), test.args...), "-p", "oos.txt", "list.txt")
+			if status, stderr := runAnot(t, dir, "", args...); status != 0 {
+				t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+			}
+			got, err := os.ReadFile(filepath.Join(dir, "list.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("list.txt is\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
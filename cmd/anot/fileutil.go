@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// readLines reads all lines of a file into a slice, preserving order.
+// The file is read in one go, through a pooled buffer, and the lines are
+// slices of a single string, so huge files cost one allocation for the
+// text instead of one per line. The slice may be handed to putLines.
+func readLines(fn string) ([]string, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+		buf.Grow(int(info.Size()) + bytes.MinRead)
+	}
+	if _, err := buf.ReadFrom(f); err != nil {
+		return nil, err
+	}
+	return splitLines(buf.String()), nil
+}
+
+// splitLines splits text like bufio.ScanLines does: a trailing newline
+// ends the last line rather than starting an empty one, and a carriage
+// return before a newline is dropped
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := getLines(strings.Count(text, "\n") + 1)
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '\n')
+		var line string
+		if i < 0 {
+			line, text = text, ""
+		} else {
+			line, text = text[:i], text[i+1:]
+		}
+		if n := len(line); n > 0 && line[n-1] == '\r' {
+			line = line[:n-1]
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// scanLines reads all lines from r into a slice, preserving order
+func scanLines(r io.Reader) ([]string, error) {
+	// Use a larger buffer for better I/O performance with large files
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	buf := make([]byte, 0, 64*1024) // 64KB buffer
+	scanner.Buffer(buf, 1024*1024)  // 1MB max token size
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+// defaultWriteBuffer is the size of output buffers unless --write-buffer
+// says otherwise
+const defaultWriteBuffer = 64 << 10
+
+// writeLines writes lines through a buffer of size bytes, so millions of
+// lines don't cost a write call each
+func writeLines(w io.Writer, lines []string, size int) error {
+	bw := bufio.NewWriterSize(w, size)
+	for _, line := range lines {
+		bw.WriteString(line)
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// writeLinesInPlace writes lines over fn with writeInPlace; size is the
+// write buffer size
+func writeLinesInPlace(fn string, lines []string, size int) error {
+	return writeInPlace(fn, func(w io.Writer) error {
+		return writeLines(w, lines, size)
+	})
+}
+
+// writeInPlace calls write with a temporary file next to fn and, once
+// everything was written and synced to disk, copies it over fn through a
+// journal. fn keeps its inode, owner and hard links, so processes
+// appending to it (a shell's >>, a log shipper) go on writing to the file
+// rather than to one no longer there, and an interrupted copy is completed
+// by repairFromJournal. This is how the files anot filters are written.
+func writeInPlace(fn string, write func(w io.Writer) error) error {
+	return writeReplacing(fn, write, replaceInPlace)
+}
+
+// writeAtomic calls write with a temporary file next to fn and renames it
+// over fn once everything was written and synced to disk
+func writeAtomic(fn string, write func(w io.Writer) error) error {
+	return writeReplacing(fn, write, replaceFile)
+}
+
+// writeReplacing calls write with a temporary file next to fn and, once
+// everything was written and synced to disk, has replace put it in place
+func writeReplacing(fn string, write func(w io.Writer) error, replace func(src, path string) error) error {
+	// Replace the file a symlink points to rather than the symlink itself
+	path, err := filepath.EvalSymlinks(fn)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		path = fn
+	}
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".anot-*")
+	if err != nil {
+		return err
+	}
+	// Clean up the temporary file on any failure before the rename
+	defer os.Remove(tmp.Name())
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return replace(tmp.Name(), path)
+}
+
+// replaceFile moves the complete, synced file src over path. Where path
+// can't be renamed over, as with a bind-mounted file or one on another
+// device, it is rewritten in place through a journal instead.
+func replaceFile(src, path string) error {
+	err := os.Rename(src, path)
+	if errors.Is(err, syscall.EBUSY) || errors.Is(err, syscall.EXDEV) {
+		return replaceViaJournal(src, path)
+	}
+	return err
+}
+
+// replaceInPlace copies the complete, synced file src over path through a
+// journal, or moves it there when there is no path yet
+func replaceInPlace(src, path string) error {
+	if _, err := os.Lstat(path); os.IsNotExist(err) {
+		return os.Rename(src, path)
+	}
+	return replaceViaJournal(src, path)
+}
+
+// writeLinesKeepingTail is writeLinesInPlace for lines filtered from the
+// first from bytes of fn, keeping whatever was appended to fn past them:
+// the watched files other tools go on appending to while they are rewritten
+func writeLinesKeepingTail(fn string, lines []string, size int, from int64) error {
+	return writeReplacing(fn, func(w io.Writer) error {
+		return writeLines(w, lines, size)
+	}, func(src, path string) error {
+		return replaceKeepingTail(src, path, from)
+	})
+}
+
+// replaceKeepingTail copies src over path in place through a journal, as
+// replaceViaJournal does, carrying the bytes of path past from over to the
+// end. The tail appended so far goes into the journal; what is appended
+// during the copy follows it into path before the file is cut to size. An
+// appender writing while the rewritten file grows past its old size, as
+// tag actions can make it, may still lose that write.
+func replaceKeepingTail(src, path string, from int64) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	end := info.Size()
+	if end < from {
+		// Cut short by someone else, the filtered lines are no longer it
+		return fmt.Errorf("%s was truncated while it was filtered", path)
+	}
+
+	tmp, err := os.OpenFile(src, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, io.NewSectionReader(f, from, end-from)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	journal := journalPath(path)
+	if err := os.Rename(src, journal); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(journal)); err != nil {
+		return err
+	}
+	j, err := os.Open(journal)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, j)
+	j.Close()
+	if err != nil {
+		return err
+	}
+	if n > end {
+		// Grown past the old end, the file is ours up to n
+		end = n
+	}
+
+	// Move what was appended during the copy down to the end of the lines
+	buf := make([]byte, 64<<10)
+	for {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if info.Size() <= end {
+			break
+		}
+		chunk := info.Size() - end
+		if chunk > int64(len(buf)) {
+			chunk = int64(len(buf))
+		}
+		k, err := f.ReadAt(buf[:chunk], end)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if _, err := f.WriteAt(buf[:k], n); err != nil {
+			return err
+		}
+		n, end = n+int64(k), end+int64(k)
+	}
+	if err := f.Truncate(n); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return os.Remove(journal)
+}
+
+// journalPath returns where the journal of an in-place write of path lives
+func journalPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".anot-journal")
+}
+
+// replaceViaJournal copies src into path in place. src first becomes the
+// journal with a rename, so the journal only ever exists complete; should
+// the copy be interrupted, repairFromJournal finishes it on the next run.
+func replaceViaJournal(src, path string) error {
+	journal := journalPath(path)
+	if err := os.Rename(src, journal); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(journal)); err != nil {
+		return err
+	}
+	if err := copyInPlace(journal, path); err != nil {
+		return err
+	}
+	return os.Remove(journal)
+}
+
+// repairFromJournal completes an in-place write of fn that was interrupted,
+// reporting whether there was one to complete
+func repairFromJournal(fn string) (bool, error) {
+	path, err := filepath.EvalSymlinks(fn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	journal := journalPath(path)
+	if _, err := os.Stat(journal); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := copyInPlace(journal, path); err != nil {
+		return false, err
+	}
+	return true, os.Remove(journal)
+}
+
+// copyInPlace overwrites dst with the contents of src, keeping dst's inode,
+// mode and owner, and syncs it to disk
+func copyInPlace(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// syncDir makes a rename in dir durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	// Not every platform and file system can sync a directory
+	if err := d.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) {
+		return err
+	}
+	return nil
+}
+
+// appendNewLines merges lines into the destination file anew-style: only
+// lines not already present are appended, and the file is written in place
+func appendNewLines(fn string, lines []string) error {
+	existing, err := readLines(fn)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	seen := make(map[string]bool, len(existing)+len(lines))
+	for _, line := range existing {
+		seen[line] = true
+	}
+
+	merged := existing
+	for _, line := range lines {
+		if !seen[line] {
+			seen[line] = true
+			merged = append(merged, line)
+		}
+	}
+	if len(merged) == len(existing) && err == nil {
+		// Nothing new, leave the destination untouched
+		return nil
+	}
+	return writeLinesInPlace(fn, merged, defaultWriteBuffer)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunKeepsTargetFiles(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "hosts.txt")
+	link := filepath.Join(dir, "link.txt")
+	moved := filepath.Join(dir, "oos.txt")
+	if err := os.WriteFile(fn, []byte("a\nb\nc\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Link(fn, link); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "scope.txt"), []byte("b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status, stderr := runAnot(t, dir, "", "-p", "scope.txt", "-q", "-move-to", moved, fn); status != 0 {
+		t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+	}
+
+	after, err := os.Stat(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(before, after) {
+		t.Error("the file was replaced rather than rewritten")
+	}
+	if after.Mode().Perm() != 0640 {
+		t.Errorf("mode %v, want 0640", after.Mode().Perm())
+	}
+	for _, f := range []string{fn, link} {
+		if data, _ := os.ReadFile(f); string(data) != "a\nc\n" {
+			t.Errorf("%s is %q, want %q", filepath.Base(f), data, "a\nc\n")
+		}
+	}
+	if data, _ := os.ReadFile(moved); string(data) != "b\n" {
+		t.Errorf("moved lines are %q, want %q", data, "b\n")
+	}
+	if _, err := os.Stat(journalPath(fn)); !os.IsNotExist(err) {
+		t.Error("the journal was left behind")
+	}
+}
+
+func TestRepairFromJournal(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string // "" for no target file
+		journal string // "" for no journal
+		want    string
+		fixed   bool
+	}{
+		{"truncated target", "a\nb", "a\nc\n", "a\nc\n", true},
+		{"missing target", "", "a\nc\n", "", false},
+		{"no journal", "a\nb\nc\n", "", "a\nb\nc\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			fn := filepath.Join(dir, "hosts.txt")
+			if tt.target != "" {
+				if err := os.WriteFile(fn, []byte(tt.target), 0640); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.journal != "" {
+				if err := os.WriteFile(journalPath(fn), []byte(tt.journal), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var before os.FileInfo
+			if tt.target != "" {
+				before, _ = os.Stat(fn)
+			}
+
+			fixed, err := repairFromJournal(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fixed != tt.fixed {
+				t.Errorf("repaired = %v, want %v", fixed, tt.fixed)
+			}
+			if tt.target == "" {
+				// A missing target has nothing to complete
+				return
+			}
+			if data, _ := os.ReadFile(fn); string(data) != tt.want {
+				t.Errorf("file %q, want %q", data, tt.want)
+			}
+			after, err := os.Stat(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !os.SameFile(before, after) || after.Mode().Perm() != 0640 {
+				t.Errorf("the file was replaced, mode %s", after.Mode())
+			}
+			if _, err := os.Stat(journalPath(fn)); !os.IsNotExist(err) {
+				t.Error("the journal was left behind")
+			}
+		})
+	}
+}
+
+func TestRunRepairsFromJournal(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "hosts.txt")
+	if err := os.WriteFile(fn, []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(journalPath(fn), []byte("a\nb\nc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "scope.txt"), []byte("b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The interrupted write is completed before the file is filtered
+	status, stderr := runAnot(t, dir, "", "-q", "-p", "scope.txt", "hosts.txt")
+	if status != 0 {
+		t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+	}
+	if !strings.Contains(stderr, "completed an interrupted write") {
+		t.Errorf("no warning; stderr:\n%s", stderr)
+	}
+	if data, _ := os.ReadFile(fn); string(data) != "a\nc\n" {
+		t.Errorf("file %q, want %q", data, "a\nc\n")
+	}
+}
+
+func TestWriteLinesKeepingTail(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		from    int64
+		lines   []string
+		want    string
+		fails   bool
+	}{
+		{"nothing appended", "a\nb\n", 4, []string{"a"}, "a\n", false},
+		{"appended after the read", "a\nb\nc\nd\n", 4, []string{"a"}, "a\nc\nd\n", false},
+		{"lines grown", "a\nb\nc\n", 4, []string{"a # tagged", "b # tagged"}, "a # tagged\nb # tagged\nc\n", false},
+		{"truncated meanwhile", "a\n", 4, []string{"a"}, "a\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := filepath.Join(t.TempDir(), "hosts.txt")
+			if err := os.WriteFile(fn, []byte(tt.content), 0640); err != nil {
+				t.Fatal(err)
+			}
+			before, err := os.Stat(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = writeLinesKeepingTail(fn, tt.lines, defaultWriteBuffer, tt.from)
+			if (err != nil) != tt.fails {
+				t.Fatalf("err = %v, want failure %v", err, tt.fails)
+			}
+			if data, _ := os.ReadFile(fn); string(data) != tt.want {
+				t.Errorf("file %q, want %q", data, tt.want)
+			}
+			after, err := os.Stat(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !os.SameFile(before, after) {
+				t.Error("the file was replaced rather than rewritten")
+			}
+			if _, err := os.Stat(journalPath(fn)); !os.IsNotExist(err) {
+				t.Error("a journal was left behind")
+			}
+		})
+	}
+}
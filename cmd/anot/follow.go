@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// follow filters r to w line by line for as long as r stays open, as a
+// filter between two long running tools. A surviving line is written as
+// soon as no more input is waiting, rather than when a buffer fills, and
+// the patterns are reloaded from src whenever their files change.
+func (j *filterJob) follow(r io.Reader, w io.Writer, src *patternSource, interval time.Duration, bloom bool) error {
+	live := anot.NewLiveMatcher(j.matcher)
+	src.reloadOn(interval, func(_ []*anot.Rule, m *anot.Matcher) {
+		if bloom {
+			m.EnableBloom()
+		}
+		live.Store(m)
+	})
+
+	keys := j.wrapKeys(j.keys)
+
+	in := bufio.NewReaderSize(r, 64<<10)
+	out := bufio.NewWriterSize(w, j.writeBuffer)
+	for {
+		raw, err := in.ReadString('\n')
+		if raw != "" {
+			line := strings.TrimSuffix(strings.TrimSuffix(raw, "\n"), "\r")
+			// Only this goroutine filters, a reload just swaps live
+			j.matcher = live.Load()
+			if kept, keep := j.filterLine(line, keys); keep && !j.quietMode {
+				out.WriteString(kept)
+				out.WriteByte('\n')
+			}
+		}
+		if err != nil || in.Buffered() == 0 {
+			if err := out.Flush(); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,196 @@
+//go:build ignore
+
+// gen_unorm generates unorm_tables.go, the Unicode normalization data of
+// -unicode, from the Unicode Character Database:
+//
+//	go run gen_unorm.go [-ucd DIR]
+//
+// DIR holds UnicodeData.txt and DerivedNormalizationProps.txt; without it
+// they are downloaded for ucdVersion from unicode.org.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const ucdVersion = "15.0.0"
+
+func main() {
+	ucd := flag.String("ucd", "", "directory with the UCD files (default: download them)")
+	out := flag.String("o", "unorm_tables.go", "file to write")
+	flag.Parse()
+
+	ccc := make(map[rune]int)
+	canon := make(map[rune][]rune)
+	compat := make(map[rune][]rune)
+	each(*ucd, "UnicodeData.txt", func(fields []string) {
+		r := parseRune(fields[0])
+		if n, _ := strconv.Atoi(fields[3]); n != 0 {
+			ccc[r] = n
+		}
+		if d := fields[5]; d != "" {
+			if strings.HasPrefix(d, "<") {
+				compat[r] = parseRunes(d[strings.Index(d, ">")+1:])
+			} else {
+				canon[r] = parseRunes(d)
+			}
+		}
+	})
+	excluded := make(map[rune]bool)
+	each(*ucd, "DerivedNormalizationProps.txt", func(fields []string) {
+		if len(fields) < 2 || fields[1] != "Full_Composition_Exclusion" {
+			return
+		}
+		first, last, ok := strings.Cut(fields[0], "..")
+		if !ok {
+			last = first
+		}
+		for r := parseRune(first); r <= parseRune(last); r++ {
+			excluded[r] = true
+		}
+	})
+
+	// Decompositions are stored fully expanded, compatibility ones only
+	// where they differ from the canonical one
+	var expand func(r rune, withCompat bool) []rune
+	expand = func(r rune, withCompat bool) []rune {
+		d, ok := canon[r]
+		if !ok && withCompat {
+			d, ok = compat[r]
+		}
+		if !ok {
+			return []rune{r}
+		}
+		var full []rune
+		for _, c := range d {
+			full = append(full, expand(c, withCompat)...)
+		}
+		return full
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go run gen_unorm.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package main\n\n")
+	fmt.Fprintf(&buf, "// unicodeNormVersion is the version of Unicode the tables are of\n")
+	fmt.Fprintf(&buf, "const unicodeNormVersion = %q\n\n", ucdVersion)
+	fmt.Fprintf(&buf, "// unormTables is parsed by loadUnormTables, one record per line:\n")
+	fmt.Fprintf(&buf, "// \"c RUNE CLASS\" the canonical combining class, \"d RUNE RUNE...\" the\n")
+	fmt.Fprintf(&buf, "// canonical and \"k RUNE RUNE...\" the compatibility decomposition, and\n")
+	fmt.Fprintf(&buf, "// \"p FIRST SECOND COMPOSITE\" a canonical composition, all in hex\n")
+	fmt.Fprintf(&buf, "const unormTables = `")
+	for _, r := range sortedRunes(ccc) {
+		fmt.Fprintf(&buf, "c %X %d\n", r, ccc[r])
+	}
+	for _, r := range sortedRunes(canon) {
+		fmt.Fprintf(&buf, "d %X%s\n", r, hexRunes(expand(r, false)))
+	}
+	all := make(map[rune]bool)
+	for r := range canon {
+		all[r] = true
+	}
+	for r := range compat {
+		all[r] = true
+	}
+	for _, r := range sortedRunes(all) {
+		if k := expand(r, true); string(k) != string(expand(r, false)) {
+			fmt.Fprintf(&buf, "k %X%s\n", r, hexRunes(k))
+		}
+	}
+	for _, r := range sortedRunes(canon) {
+		if d := canon[r]; len(d) == 2 && !excluded[r] {
+			fmt.Fprintf(&buf, "p %X %X %X\n", d[0], d[1], r)
+		}
+	}
+	fmt.Fprintf(&buf, "`\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// each calls fn with the fields of every data line of a UCD file
+func each(dir, name string, fn func(fields []string)) {
+	var r io.Reader
+	if dir != "" {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get("https://www.unicode.org/Public/" + ucdVersion + "/ucd/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("%s: %s", name, resp.Status)
+		}
+		r = resp.Body
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(n)
+}
+
+func parseRunes(s string) []rune {
+	var runes []rune
+	for _, f := range strings.Fields(s) {
+		runes = append(runes, parseRune(f))
+	}
+	return runes
+}
+
+func hexRunes(runes []rune) string {
+	var b strings.Builder
+	for _, r := range runes {
+		fmt.Fprintf(&b, " %X", r)
+	}
+	return b.String()
+}
+
+func sortedRunes[V any](m map[rune]V) []rune {
+	runes := make([]rune, 0, len(m))
+	for r := range m {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
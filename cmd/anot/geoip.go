@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// geoCheck removes IP lines located in the given countries or continents,
+// according to a local MaxMind DB such as GeoLite2-Country
+type geoCheck struct {
+	db *mmdbReader
+	// path is the record field holding the code, e.g. country.iso_code
+	path []string
+	// rules are keyed by upper-cased code
+	rules map[string]*anot.Rule
+}
+
+// newGeoCheck parses a --geoip spec: country:US,DE or continent:EU
+func newGeoCheck(spec string, db *mmdbReader) (*geoCheck, error) {
+	kind, list, _ := strings.Cut(spec, ":")
+	g := &geoCheck{db: db, rules: make(map[string]*anot.Rule)}
+	switch kind {
+	case "country":
+		g.path = []string{"country", "iso_code"}
+	case "continent":
+		g.path = []string{"continent", "code"}
+	default:
+		return nil, fmt.Errorf("unknown geoip filter %q (want country:CODES or continent:CODES)", spec)
+	}
+	for _, code := range strings.Split(list, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code != "" {
+			g.rules[code] = &anot.Rule{Pattern: kind + ":" + code, Action: anot.ActionRemove}
+		}
+	}
+	if len(g.rules) == 0 {
+		return nil, fmt.Errorf("geoip filter %q lists no codes", spec)
+	}
+	return g, nil
+}
+
+func (g *geoCheck) wants(key string) bool {
+	return net.ParseIP(key) != nil
+}
+
+func (g *geoCheck) check(ctx context.Context, key string) *anot.Rule {
+	record, err := g.db.lookup(net.ParseIP(key))
+	if err != nil || record == nil {
+		return nil
+	}
+	code, _ := mmdbPath(record, g.path...).(string)
+	if code == "" && g.path[0] == "country" {
+		// Anycast and satellite ranges often only carry the registration
+		code, _ = mmdbPath(record, "registered_country", "iso_code").(string)
+	}
+	return g.rules[strings.ToUpper(code)]
+}
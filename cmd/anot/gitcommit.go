@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// recordRemovals notes how many lines were removed from fn, for -git-commit
+func (j *filterJob) recordRemovals(fn string, removed int) {
+	if j.gitCommit == "" || removed == 0 || j.dryRun {
+		return
+	}
+	j.removalsMu.Lock()
+	if j.removals == nil {
+		j.removals = make(map[string]int)
+	}
+	j.removals[fn] += removed
+	j.removalsMu.Unlock()
+}
+
+// commitRemovals commits the files lines were removed from to the git
+// repositories they are tracked in, one commit per repository with the
+// -git-commit message and a summary of the removals. The files are already
+// filtered, so anything going wrong is only a warning.
+func (j *filterJob) commitRemovals(stderr io.Writer) {
+	type change struct {
+		path    string
+		removed int
+	}
+	repos := make(map[string][]change)
+	for fn, removed := range j.removals {
+		abs, err := filepath.Abs(fn)
+		if err != nil {
+			fmt.Fprintf(stderr, "warning: -git-commit: %s: %s\n", fn, err)
+			continue
+		}
+		top, err := git(filepath.Dir(abs), nil, "rev-parse", "--show-toplevel")
+		if err != nil {
+			fmt.Fprintf(stderr, "warning: -git-commit: %s is not in a git repository, not committed\n", fn)
+			continue
+		}
+		root := strings.TrimSpace(string(top))
+		rel, err := filepath.Rel(root, abs)
+		if err != nil {
+			fmt.Fprintf(stderr, "warning: -git-commit: %s: %s\n", fn, err)
+			continue
+		}
+		if _, err := git(root, nil, "ls-files", "--error-unmatch", "--", rel); err != nil {
+			fmt.Fprintf(stderr, "warning: -git-commit: %s is not tracked by git, not committed\n", fn)
+			continue
+		}
+		repos[root] = append(repos[root], change{filepath.ToSlash(rel), removed})
+	}
+
+	roots := make([]string, 0, len(repos))
+	for root := range repos {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	for _, root := range roots {
+		changes := repos[root]
+		sort.Slice(changes, func(a, b int) bool { return changes[a].path < changes[b].path })
+		total := 0
+		var summary strings.Builder
+		paths := make([]string, len(changes))
+		for i, c := range changes {
+			total += c.removed
+			paths[i] = c.path
+			fmt.Fprintf(&summary, "%s: removed %d lines\n", c.path, c.removed)
+		}
+		body := fmt.Sprintf("anot removed %d lines from %d files:\n\n%s", total, len(paths), summary.String())
+		// Only these paths are committed, whatever else is staged
+		args := append([]string{"commit", "-q", "-m", j.gitCommit, "-m", body, "--"}, paths...)
+		if _, err := git(root, nil, args...); err != nil {
+			fmt.Fprintf(stderr, "warning: -git-commit: %s\n", err)
+			continue
+		}
+		fmt.Fprintf(stderr, "committed the removals from %d files to %s\n", len(paths), root)
+	}
+}
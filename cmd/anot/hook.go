@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// stagedLine is a line a commit adds to a file
+type stagedLine struct {
+	fn   string
+	line string
+}
+
+// runHook implements "anot hook": as a git pre-commit hook, the lines a
+// commit adds to the staged files matching -glob are checked against the
+// patterns, and the commit is blocked if any of them would be removed. With
+// -fix the staged files are filtered instead, in the index and the working
+// tree. A hook blocks a commit by failing, so unlike other errors
+// everything going wrong here exits 1.
+func runHook(args []string) {
+	fs := flag.NewFlagSet("anot hook", flag.ExitOnError)
+	var staged bool
+	var fix bool
+	var globs stringList
+	var patternFiles stringList
+	var compiledFile string
+	var asnDBFile string
+	var trim bool
+	var extended bool
+	fs.BoolVar(&staged, "staged", false, "check the changes staged for commit")
+	fs.BoolVar(&fix, "fix", false, "filter the matching staged files, in the index and the working tree, instead of blocking the commit")
+	fs.Var(&globs, "glob", "check the staged files whose path from the top of the repository matches this pattern (repeatable)")
+	fs.Var(&patternFiles, "p", "read patterns from this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	fs.StringVar(&asnDBFile, "asn-db", "", "ASN database for asn:NUMBER patterns")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot hook -staged -glob PATTERN... -p patterns.txt [options]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if !staged || len(globs) == 0 || fs.NArg() > 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	for _, g := range globs {
+		if _, err := filepath.Match(g, ""); err != nil {
+			hookFail("-glob %q: %s", g, err)
+		}
+	}
+
+	// Pattern files are given relative to where the hook runs, the top of
+	// the repository for git hooks
+	src := &patternSource{who: "anot hook", compiledFile: compiledFile, patternFiles: patternFiles,
+		asnDBFile: asnDBFile, extended: extended, trim: trim}
+	_, matcher, err := src.load()
+	if err != nil {
+		hookFail("%s", err)
+	}
+	job := &filterJob{matcher: matcher, trim: trim}
+
+	top, err := git("", nil, "rev-parse", "--show-toplevel")
+	if err != nil {
+		hookFail("%s", err)
+	}
+	root := strings.TrimSpace(string(top))
+	names, err := git(root, nil, "diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR")
+	if err != nil {
+		hookFail("%s", err)
+	}
+	var files []string
+	for _, fn := range strings.Split(string(names), "\x00") {
+		for _, g := range globs {
+			if ok, _ := filepath.Match(g, fn); ok && fn != "" {
+				files = append(files, fn)
+				break
+			}
+		}
+	}
+
+	var blocked []stagedLine
+	for _, fn := range files {
+		added, err := stagedAdditions(root, fn)
+		if err != nil {
+			hookFail("%s: %s", fn, err)
+		}
+		for _, line := range added {
+			if _, keep := job.filterLine(line, nil); !keep {
+				blocked = append(blocked, stagedLine{fn, line})
+			}
+		}
+	}
+	if len(blocked) == 0 {
+		return
+	}
+
+	if !fix {
+		for _, b := range blocked {
+			fmt.Fprintf(os.Stderr, "%s: out of scope: %s\n", b.fn, b.line)
+		}
+		hookFail("the commit adds %d out-of-scope lines; remove them, or run anot hook -staged -fix", len(blocked))
+	}
+	fixed := make(map[string]bool)
+	for _, b := range blocked {
+		if fixed[b.fn] {
+			continue
+		}
+		fixed[b.fn] = true
+		removed, err := job.fixStaged(root, b.fn)
+		if err != nil {
+			hookFail("%s: %s", b.fn, err)
+		}
+		fmt.Fprintf(os.Stderr, "%s: removed %d out-of-scope lines\n", b.fn, removed)
+	}
+}
+
+func hookFail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "error: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+// git runs a git command in dir, returning its output
+func git(dir string, stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("git %s: %s", args[0], err)
+	}
+	return out, nil
+}
+
+// stagedAdditions returns the lines the staged changes add to a file
+func stagedAdditions(root, fn string) ([]string, error) {
+	diff, err := git(root, nil, "diff", "--cached", "--unified=0", "--no-color", "--no-ext-diff", "--", fn)
+	if err != nil {
+		return nil, err
+	}
+	var added []string
+	inHunk := false
+	scanner := bufio.NewScanner(bytes.NewReader(diff))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk:
+			// The header, up to the first hunk
+		case strings.HasPrefix(line, "+"):
+			added = append(added, strings.TrimSuffix(line[1:], "\r"))
+		}
+	}
+	return added, scanner.Err()
+}
+
+// fixStaged filters the staged version of a file in the index, and the
+// file in the working tree, returning how many staged lines were removed
+func (j *filterJob) fixStaged(root, fn string) (int, error) {
+	entry, err := git(root, nil, "ls-files", "--stage", "--", fn)
+	if err != nil {
+		return 0, err
+	}
+	mode := strings.Fields(string(entry))
+	if len(mode) == 0 {
+		return 0, fmt.Errorf("not in the index")
+	}
+	content, err := git(root, nil, "show", ":"+fn)
+	if err != nil {
+		return 0, err
+	}
+	lines, err := scanLines(bytes.NewReader(content))
+	if err != nil {
+		return 0, err
+	}
+	filtered := j.filterLines(lines)
+	var buf bytes.Buffer
+	if err := writeLines(&buf, filtered, defaultWriteBuffer); err != nil {
+		return 0, err
+	}
+	blob, err := git(root, buf.Bytes(), "hash-object", "-w", "--stdin", "--path", fn)
+	if err != nil {
+		return 0, err
+	}
+	info := mode[0] + "," + strings.TrimSpace(string(blob)) + "," + fn
+	if _, err := git(root, nil, "update-index", "--cacheinfo", info); err != nil {
+		return 0, err
+	}
+
+	// The working tree loses the same lines, or the next commit adds them
+	// back; its unstaged changes stay, but for the lines filtered out
+	path := filepath.Join(root, fn)
+	worktree, err := readLines(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file: %s", err)
+	}
+	if err := writeLinesInPlace(path, j.filterLines(worktree), defaultWriteBuffer); err != nil {
+		return 0, fmt.Errorf("failed to write file: %s", err)
+	}
+	return len(lines) - len(filtered), nil
+}
+
+// filterLines returns the surviving lines, with the rule actions applied
+func (j *filterJob) filterLines(lines []string) []string {
+	filtered := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if out, keep := j.filterLine(line, j.keys); keep {
+			filtered = append(filtered, out)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// span is the byte range of a whitespace-separated token in a line
+type span struct {
+	start, end int
+}
+
+// tokenSpans returns the spans of the whitespace-separated tokens of s
+func tokenSpans(s string) []span {
+	var spans []span
+	start := -1
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ' ' || s[i] == '\t' {
+			if start >= 0 {
+				spans = append(spans, span{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return spans
+}
+
+// filterHostsLine applies the rules to one line of a hosts(5) file:
+//
+//	ADDRESS HOSTNAME [ALIASES...] [# comment]
+//
+// When the address matches, or wholeLine is set and any hostname matches,
+// the matching rule is returned and the whole line is handled by it. When
+// only some hostnames match, they are cut out of the line, keeping the rest
+// of its formatting, and removed describes the dropped entry ("ADDRESS
+// NAME..."). A line left without hostnames is removed entirely.
+func filterHostsLine(line string, m *anot.Matcher, trim, wholeLine bool) (out string, rule *anot.Rule, removed string) {
+	body := line
+	if i := strings.Index(line, "#"); i >= 0 {
+		body = line[:i]
+	}
+	spans := tokenSpans(body)
+	if len(spans) < 2 {
+		// Blank, comment-only or malformed line
+		if trim {
+			return line, m.Match(strings.TrimSpace(line)), ""
+		}
+		return line, m.Match(line), ""
+	}
+
+	addr := body[spans[0].start:spans[0].end]
+	if rule := m.Match(addr); rule != nil {
+		return line, rule, ""
+	}
+
+	var drop []span
+	var names []string
+	var first *anot.Rule
+	for _, sp := range spans[1:] {
+		name := body[sp.start:sp.end]
+		if r := m.Match(name); r != nil {
+			if wholeLine {
+				return line, r, ""
+			}
+			if first == nil {
+				first = r
+			}
+			drop = append(drop, sp)
+			names = append(names, name)
+		}
+	}
+	if len(drop) == 0 {
+		return line, nil, ""
+	}
+	if len(drop) == len(spans)-1 {
+		return line, first, ""
+	}
+
+	// Cut each dropped name together with the whitespace before it
+	var b strings.Builder
+	prev := 0
+	for _, sp := range drop {
+		start := sp.start
+		for start > 0 && (body[start-1] == ' ' || body[start-1] == '\t') {
+			start--
+		}
+		b.WriteString(line[prev:start])
+		prev = sp.end
+	}
+	b.WriteString(line[prev:])
+	return b.String(), nil, addr + " " + strings.Join(names, " ")
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestFilterHostsLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wholeLine bool
+		out       string
+		removed   bool
+		dropped   string
+	}{
+		{"address matches", "10.0.0.1 nas.lan nas", false, "10.0.0.1 nas.lan nas", true, ""},
+		{"no match", "192.168.1.1 router.lan", false, "192.168.1.1 router.lan", false, ""},
+		{"one alias", "127.0.0.1\tlocalhost ads.example.com # block", false, "127.0.0.1\tlocalhost # block", false,
+			"127.0.0.1 ads.example.com"},
+		{"several aliases", "0.0.0.0 ads.example.com tracker.example.net keep.example.org", false,
+			"0.0.0.0 keep.example.org", false, "0.0.0.0 ads.example.com tracker.example.net"},
+		{"every name", "0.0.0.0 ads.example.com tracker.example.net", false,
+			"0.0.0.0 ads.example.com tracker.example.net", true, ""},
+		{"whole line", "127.0.0.1 localhost ads.example.com", true, "127.0.0.1 localhost ads.example.com", true, ""},
+		{"comment only", "# ads.example.com", false, "# ads.example.com", false, ""},
+		{"name in comment", "192.168.1.1 router.lan # ads.example.com", false,
+			"192.168.1.1 router.lan # ads.example.com", false, ""},
+	}
+	rules, err := anot.ReadRules(strings.NewReader("10.0.0.0/8\nads.example.com\ntracker.example.net\n"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := anot.NewMatcher(rules)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, rule, dropped := filterHostsLine(tt.line, m, false, tt.wholeLine)
+			if out != tt.out {
+				t.Errorf("out = %q, want %q", out, tt.out)
+			}
+			if (rule != nil) != tt.removed {
+				t.Errorf("removed = %v, want %v", rule != nil, tt.removed)
+			}
+			if dropped != tt.dropped {
+				t.Errorf("dropped = %q, want %q", dropped, tt.dropped)
+			}
+		})
+	}
+}
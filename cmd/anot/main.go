@@ -0,0 +1,862 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "sync":
+			exitOn(runSync(os.Args[2:]))
+			return
+		case "presets":
+			runPresets()
+			return
+		case "compile":
+			exitOn(runCompile(os.Args[2:]))
+			return
+		case "daemon":
+			exitOn(runDaemon(os.Args[2:]))
+			return
+		case "client":
+			exitOn(runClient(os.Args[2:]))
+			return
+		case "watch":
+			exitOn(runWatch(os.Args[2:]))
+			return
+		case "serve":
+			exitOn(runServe(os.Args[2:]))
+			return
+		case "cron":
+			exitOn(runCron(os.Args[2:]))
+			return
+		case "control":
+			runControl(os.Args[2:])
+			return
+		case "hook":
+			runHook(os.Args[2:])
+			return
+		case "doctor":
+			runDoctor(os.Args[2:])
+			return
+		case "plan":
+			runPlan(os.Args[2:])
+			return
+		case "apply":
+			runApply(os.Args[2:])
+			return
+		}
+	}
+
+	// An error sets the exit status of its kind, exited with once every
+	// deferred cleanup ran
+	status := 0
+	defer func() {
+		if status != 0 {
+			os.Exit(status)
+		}
+	}()
+	fail := func(err error) {
+		writeError(os.Stderr, err)
+		status = exitStatus(err)
+	}
+
+	var quietMode bool
+	var dryRun bool
+	var trim bool
+	var cleanup cleaning
+	var unicodeForm string
+	var ignoreZones bool
+	var extended bool
+	var moveTo string
+	var webhook string
+	var gitCommit string
+	var followStdin bool
+	var reloadInterval time.Duration
+	var withComments bool
+	var dedupe bool
+	var sortOrder string
+	var unique bool
+	var reportDupes bool
+	var summarizeRemoved bool
+	var report string
+	var minCount int
+	var maxCount int
+	var field int
+	var delim string
+	var jsonPath string
+	var csvMode bool
+	var csvColumn string
+	var extract string
+	var preset string
+	var hostsMode bool
+	var hostsWholeLine bool
+	var zoneMode bool
+	var zoneOrigin string
+	var nmapXML bool
+	var patternFiles stringList
+	var emailMode bool
+	var fromCerts stringList
+	var resolve bool
+	var ptr bool
+	var pruneDead bool
+	var dnsRetries int
+	var wildcardDNS bool
+	var aliveSpec string
+	var geoSpec string
+	var mmdbFile string
+	var asnDBFile string
+	var removeCDN bool
+	var cdnRanges string
+	var enrichSpecs stringList
+	var pluginSpecs stringList
+	var exprSpecs stringList
+	var normalizeSpecs stringList
+	var keepIfSpecs stringList
+	var useBloom bool
+	var compileCache bool
+	var compiledFile string
+	var server string
+	var serverToken string
+	var prof profiles
+	var showStats bool
+	var exactBudget int
+	var stream bool
+	var noProgress bool
+	var checkpointFile string
+	var writeBuffer int
+	var maxMemory string
+	var workers int
+	var ioConcurrency int
+	var resolvers stringList
+	var resolversFile string
+	var resolverRate float64
+	var rate string
+	var offline bool
+	var useCache bool
+	var cacheFile string
+	var cacheTTL time.Duration
+	var probeTimeout time.Duration
+	var timeout time.Duration
+	var keepMatched bool
+	var concurrency int
+	var dnsTimeout time.Duration
+	flag.Var(&patternFiles, "p", "read patterns from this file instead of stdin (repeatable)")
+	flag.BoolVar(&quietMode, "q", false, "quiet mode (no output at all)")
+	flag.BoolVar(&dryRun, "d", false, "don't write to file, just print the filtered result to stdout")
+	flag.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	flag.StringVar(&unicodeForm, "unicode", "", "normalize lines and patterns to this Unicode form before comparison: nfc, or nfkc to also fold compatibility characters such as fullwidth letters")
+	flag.BoolVar(&cleanup.squeeze, "squeeze-space", false, "collapse every run of whitespace in lines and patterns into one space before comparison")
+	flag.BoolVar(&cleanup.tabs, "normalize-tabs", false, "turn tabs and Unicode spaces such as NBSP in lines and patterns into plain spaces before comparison")
+	flag.BoolVar(&cleanup.invisible, "strip-invisible", false, "drop zero-width and other invisible characters from lines and patterns before comparison")
+	flag.BoolVar(&cleanup.refang, "refang", false, "undo the defanging of indicators in lines and patterns before comparison: hxxp:// becomes http://, example[.]com example.com")
+	flag.BoolVar(&cleanup.urls, "url", false, "compare the URLs of lines and patterns in canonical form: scheme and host lowercased, default ports and trailing slashes dropped")
+	flag.BoolVar(&cleanup.sortQuery, "sort-query", false, "with -url, also sort the query parameters of URLs")
+	flag.BoolVar(&cleanup.anyScheme, "any-scheme", false, "with -url, match URLs whatever their scheme, and remove every URL on a host a bare host pattern or scheme://host names")
+	flag.BoolVar(&ignoreZones, "ignore-zones", false, "also match IPv6 addresses without their zone and in their canonical form, so unqualified patterns like fe80::/10 remove fe80::1%eth0")
+	flag.BoolVar(&extended, "x", false, "extended pattern syntax: 'PATTERN [remove|comment|replace ARG|tag ARG]' per line")
+	flag.StringVar(&moveTo, "move-to", "", "append removed lines to this file (anew-style) instead of discarding them")
+	flag.StringVar(&gitCommit, "git-commit", "", "commit the files lines were removed from, in the git repositories tracking them, with this message and a summary of the removals")
+	flag.StringVar(&webhook, "webhook", "", "POST a JSON summary of the removals to this URL for every file lines are removed from")
+	flag.BoolVar(&withComments, "with-comments", false, "also remove the comment lines directly preceding a removed line")
+	flag.BoolVar(&dedupe, "dedupe", false, "drop duplicate surviving lines, keeping the first occurrence")
+	flag.StringVar(&sortOrder, "sort", "", "sort the filtered result: lex, ip or natural")
+	flag.BoolVar(&unique, "u", false, "guarantee every output line appears only once")
+	flag.BoolVar(&reportDupes, "report-dupes", false, "with -u, report how many duplicate lines were collapsed (implies -u)")
+	flag.BoolVar(&summarizeRemoved, "summarize-removed", false, "print the removed IPs aggregated into minimal CIDR blocks to stderr")
+	flag.StringVar(&report, "report", "", "print a report of the surviving lines to stderr: apex (domains grouped by registrable domain)")
+	flag.IntVar(&minCount, "min-count", 0, "remove lines appearing fewer than N times in the file")
+	flag.IntVar(&maxCount, "max-count", 0, "remove lines appearing more than N times in the file")
+	flag.IntVar(&field, "field", 0, "match patterns against the n-th field (1-based) instead of the whole line")
+	flag.StringVar(&delim, "delim", "", "field delimiter for -field (default: runs of whitespace)")
+	flag.StringVar(&jsonPath, "json-key", "", "parse lines as JSON and match the value at this jq-style path (e.g. .host, .a[])")
+	flag.BoolVar(&csvMode, "csv", false, "treat the file as CSV with a header row, see -column")
+	flag.StringVar(&csvColumn, "column", "", "with -csv, match patterns against the column with this header name")
+	flag.StringVar(&extract, "extract", "", "match a token pulled out of each line: host, ip or domain")
+	flag.StringVar(&preset, "preset", "", "match the fields a known line format cares about: "+presetUsage())
+	flag.BoolVar(&hostsMode, "hosts-file", false, "treat the file as hosts(5): remove matching hostnames from lines, or lines whose address matches")
+	flag.BoolVar(&hostsWholeLine, "hosts-whole-line", false, "with -hosts-file, remove the whole line when any hostname on it matches")
+	flag.BoolVar(&zoneMode, "zone-file", false, "treat the file as a BIND zone: remove records whose owner name matches")
+	flag.StringVar(&zoneOrigin, "zone-origin", "", "with -zone-file, origin for relative names until the first $ORIGIN")
+	flag.BoolVar(&nmapXML, "nmap-xml", false, "treat the file as nmap XML output: remove <host> elements whose address or hostname matches")
+	flag.BoolVar(&emailMode, "email", false, "also match the domain part of email addresses against the patterns")
+	flag.Var(&fromCerts, "from-cert", "use the CN/SAN names of a PEM certificate file, or of the certificate served at host:port, as patterns (repeatable)")
+	flag.BoolVar(&resolve, "resolve", false, "resolve domain lines and remove them when an address matches the IP/CIDR patterns, or their CNAME chain ends at a matched name")
+	flag.BoolVar(&ptr, "ptr", false, "reverse resolve IP lines and remove them when a PTR name matches the patterns")
+	flag.BoolVar(&pruneDead, "prune-dead", false, "resolve domain lines and remove those that do not exist (NXDOMAIN)")
+	flag.IntVar(&dnsRetries, "retries", 2, "extra attempts for a failed DNS lookup before giving up")
+	flag.BoolVar(&wildcardDNS, "wildcard-dns", false, "remove subdomains that only resolve because of a wildcard record in their parent zone")
+	flag.StringVar(&aliveSpec, "alive", "", "probe each host (tcp:PORT, http[:PORT] or https[:PORT]) and remove unresponsive ones")
+	flag.DurationVar(&probeTimeout, "probe-timeout", 5*time.Second, "timeout of a single -alive probe")
+	flag.BoolVar(&keepMatched, "k", false, "invert: keep the matched lines (e.g. unresponsive hosts with -alive) and remove the rest")
+	flag.StringVar(&geoSpec, "geoip", "", "remove IP lines located in these countries or continents (country:US,DE or continent:EU), needs -mmdb")
+	flag.StringVar(&mmdbFile, "mmdb", "", "MaxMind DB file (e.g. GeoLite2-Country.mmdb) for -geoip")
+	flag.StringVar(&asnDBFile, "asn-db", "", "ASN database (GeoLite2-ASN MaxMind DB, or CSV/TSV ranges) for asn:NUMBER patterns")
+	flag.BoolVar(&removeCDN, "remove-cdn", false, "also remove Cloudflare, Akamai, Fastly and CloudFront edge addresses (and, with -resolve, names hosted there)")
+	flag.StringVar(&cdnRanges, "cdn-ranges", "", "read the -remove-cdn ranges from this file instead of the built-in list")
+	flag.Var(&exprSpecs, "expr", "also remove lines for which this expression holds, e.g. 'line.endsWith(\".int\") || ip_in(line, \"10.0.0.0/8\")' (repeatable)")
+	flag.Var(&normalizeSpecs, "normalize", "match the value this expression makes of each line instead, e.g. 'lower(line.trimPrefix(\"ID-\"))' (repeatable, applied in order)")
+	flag.Var(&keepIfSpecs, "keep-if", "keep lines for which this expression holds even when they match (repeatable)")
+	flag.Var(&pluginSpecs, "plugin", "match NAME:ARGUMENT patterns with the program NAME=COMMAND, speaking the plugin protocol on stdin and stdout (repeatable)")
+	flag.Var(&enrichSpecs, "enrich", "enrichment provider (internetdb, ipinfo or NAME=URL with {} for the key) whose fields provider.field:value patterns match (repeatable)")
+	flag.BoolVar(&useBloom, "bloom", false, "front the exact-match patterns with a bloom filter (for pattern sets with millions of entries)")
+	flag.IntVar(&exactBudget, "exact-budget", 0, "memory budget in MB for exact-match patterns; past it they are kept in a disk-backed set in the temp directory")
+	flag.StringVar(&maxMemory, "max-memory", "", "memory budget such as 2G: exact patterns spill to disk past half of it (unless -exact-budget is set) and files too large for the rest are streamed")
+	flag.BoolVar(&compileCache, "compile-cache", false, "keep the compiled patterns in the user cache directory, keyed by a hash of the patterns, so later runs with the same patterns skip compiling them")
+	flag.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	flag.StringVar(&server, "server", "", "load the patterns from the \"anot serve\" at this URL, cached for when it can't be reached")
+	flag.StringVar(&serverToken, "server-token", os.Getenv("ANOT_TOKEN"), "bearer token for -server (default $ANOT_TOKEN)")
+	flag.Var(&resolvers, "resolver", "DNS server (IP[:PORT]) or DoH URL (https://...) to use instead of the system resolver (repeatable, used round-robin)")
+	flag.StringVar(&resolversFile, "resolvers", "", "read resolvers, one per line, from a file")
+	flag.Float64Var(&resolverRate, "resolver-rate", 0, "maximum lookups per second sent to each resolver (0 for no limit)")
+	flag.BoolVar(&offline, "offline", false, "fail if any enabled feature would perform network I/O")
+	flag.StringVar(&rate, "rate", "", "global limit on network lookups across all online features, e.g. 50/s or 300/m")
+	flag.BoolVar(&useCache, "cache", false, "keep DNS and other lookup results in a persistent cache across runs")
+	flag.StringVar(&cacheFile, "cache-file", "", "cache file location (default: anot/cache.json in the user cache directory)")
+	flag.DurationVar(&cacheTTL, "cache-ttl", 24*time.Hour, "how long cached lookup results stay valid")
+	flag.IntVar(&concurrency, "concurrency", 20, "number of concurrent network lookups")
+	flag.IntVar(&workers, "workers", 0, "number of CPU cores to use for matching and everything else (0 for all)")
+	flag.IntVar(&ioConcurrency, "io-concurrency", 0, "number of target files read, filtered and written at once (0 for one per worker)")
+	flag.DurationVar(&timeout, "timeout", 0, "stop lookups after this long and leave the files not written yet unchanged, e.g. 10m (default: no limit)")
+	flag.DurationVar(&dnsTimeout, "dns-timeout", 5*time.Second, "timeout of a single DNS lookup")
+	flag.BoolVar(&followStdin, "follow-stdin", false, "filter stdin to stdout for as long as it stays open, writing each surviving line at once and reloading the pattern files when they change")
+	flag.DurationVar(&reloadInterval, "reload-interval", 2*time.Second, "with -follow-stdin, how often the pattern files are checked for changes (0 to reload only on SIGHUP)")
+	flag.BoolVar(&stream, "stream", false, "filter line by line in bounded memory, for files too large to hold; whole-file options are unavailable")
+	flag.StringVar(&checkpointFile, "checkpoint", "", "with -stream, record progress in this file so an interrupted run resumes where it stopped (implies -stream)")
+	flag.IntVar(&writeBuffer, "write-buffer", defaultWriteBuffer>>10, "size in KB of the buffers output is written through")
+	flag.BoolVar(&noProgress, "no-progress", false, "don't show progress on stderr, which is shown for long runs when it is a terminal")
+	flag.BoolVar(&jsonErrors, "json-errors", false, "print errors to stderr as JSON objects with their kind and exit status")
+	flag.BoolVar(&showStats, "stats", false, "print peak memory, allocations and throughput to stderr when done")
+	flag.StringVar(&prof.cpu, "cpuprofile", "", "write a CPU profile to this file")
+	flag.StringVar(&prof.mem, "memprofile", "", "write a heap profile to this file when done")
+	flag.StringVar(&prof.trace, "trace", "", "write an execution trace to this file")
+	flag.Parse()
+	stats := newRunStats()
+
+	stopProfiling, err := prof.start()
+	if err != nil {
+		fail(ioError("", err))
+		return
+	}
+	defer func() {
+		if err := stopProfiling(); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to write profile: %s\n", err)
+		}
+	}()
+
+	if workers < 0 || ioConcurrency < 0 {
+		fail(usageError("-workers and -io-concurrency can't be negative"))
+		return
+	}
+	if workers > 0 {
+		runtime.GOMAXPROCS(workers)
+	}
+	if ioConcurrency == 0 {
+		ioConcurrency = runtime.GOMAXPROCS(0)
+	}
+	var memoryBudget int64
+	if maxMemory != "" {
+		if memoryBudget, err = parseSize(maxMemory); err != nil || memoryBudget == 0 {
+			fail(usageError("-max-memory: invalid size %q", maxMemory))
+			return
+		}
+	}
+	if writeBuffer <= 0 {
+		fail(usageError("-write-buffer must be positive"))
+		return
+	}
+	if sortOrder != "" {
+		if err := checkSortOrder(sortOrder); err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+	}
+	if report != "" && report != "apex" {
+		fail(usageError("unknown report %q (want apex)", report))
+		return
+	}
+	if offline {
+		var online []string
+		for name, enabled := range map[string]bool{
+			"--resolve":      resolve,
+			"--ptr":          ptr,
+			"--prune-dead":   pruneDead,
+			"--wildcard-dns": wildcardDNS,
+			"--alive":        aliveSpec != "",
+			"--enrich":       len(enrichSpecs) > 0,
+			"--server":       server != "",
+			"--webhook":      webhook != "",
+		} {
+			if enabled {
+				online = append(online, name)
+			}
+		}
+		for _, source := range fromCerts {
+			// Anything but a readable certificate file is fetched from a server
+			if _, err := os.Stat(source); err != nil {
+				online = append(online, "--from-cert "+source)
+			}
+		}
+		if len(online) > 0 {
+			sort.Strings(online)
+			fail(guardError("--offline forbids network features: %s", strings.Join(online, ", ")))
+			return
+		}
+	}
+	if unicodeForm != "" {
+		if err := cleanup.setForm(unicodeForm); err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+	}
+	if cleanup.sortQuery && !cleanup.urls {
+		fail(usageError("-sort-query needs -url"))
+		return
+	}
+	if cleanup.anyScheme && !cleanup.urls {
+		fail(usageError("-any-scheme needs -url"))
+		return
+	}
+	var hooks *anot.Hooks
+	clean := cleanup.cleaner()
+	if len(exprSpecs)+len(normalizeSpecs)+len(keepIfSpecs) > 0 || clean != nil {
+		hooks = &anot.Hooks{Clean: clean}
+	}
+	for _, src := range normalizeSpecs {
+		f, err := parseNormalize(src)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		hooks.Normalize = append(hooks.Normalize, f)
+	}
+	for _, src := range exprSpecs {
+		e, err := parseExpr(src)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		hooks.Exprs = append(hooks.Exprs, e)
+	}
+	for _, src := range keepIfSpecs {
+		e, err := parseExpr(src)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		hooks.KeepIf = append(hooks.KeepIf, e)
+	}
+	if rate != "" {
+		perSecond, err := parseRate(rate)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		netRate = newRateLimiter(perSecond)
+	}
+
+	files := flag.Args()
+	// With -follow-stdin stdin carries the lines, so the patterns come from
+	// files, from which they are reloaded
+	var follow *patternSource
+	if followStdin {
+		if len(files) > 0 {
+			fail(usageError("-follow-stdin filters stdin to stdout and takes no filename"))
+			return
+		}
+		if len(patternFiles) == 0 && compiledFile == "" {
+			fail(usageError("-follow-stdin needs pattern files or -compiled"))
+			return
+		}
+		var conflicts []string
+		for name, enabled := range map[string]bool{
+			"-from-cert":    len(fromCerts) > 0,
+			"-remove-cdn":   removeCDN || cdnRanges != "",
+			"-server":       server != "",
+			"-exact-budget": exactBudget > 0 || memoryBudget > 0,
+			"-stream":       stream || checkpointFile != "",
+			"-move-to":      moveTo != "",
+			"-git-commit":   gitCommit != "",
+			"-webhook":      webhook != "",
+			"-timeout":      timeout > 0,
+		} {
+			if enabled {
+				conflicts = append(conflicts, name)
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			fail(usageError("-follow-stdin can't be combined with %s", strings.Join(conflicts, ", ")))
+			return
+		}
+		follow = &patternSource{who: "-follow-stdin", compiledFile: compiledFile, patternFiles: patternFiles,
+			asnDBFile: asnDBFile, extended: extended, trim: trim, hooks: hooks}
+		// Stamped before loading, so a change while loading means a reload
+		follow.stamps = follow.stampFiles()
+	} else if len(files) == 0 {
+		fail(usageError("no filename provided"))
+		return
+	}
+	// Finish in-place writes an earlier run was interrupted in
+	for _, fn := range files {
+		repaired, err := repairFromJournal(fn)
+		if err != nil {
+			fail(ioError(fn, fmt.Errorf("failed to repair from journal: %s", err)))
+			return
+		}
+		if repaired {
+			fmt.Fprintf(os.Stderr, "warning: %s: completed an interrupted write from its journal\n", fn)
+		}
+	}
+	// --timeout bounds everything from here on, lookups and writes included,
+	// and SIGINT or SIGTERM stops it the same way, though never halfway
+	// through a write; a second signal kills anot. Following a pipe stops
+	// when the pipe ends instead.
+	if timeout < 0 {
+		fail(usageError("-timeout can't be negative"))
+		return
+	}
+	ctx := context.Background()
+	if follow == nil {
+		var stop context.CancelFunc
+		ctx, stop = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			stop()
+		}()
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Read the first target while the patterns load; XML and streamed
+	// targets are read their own way, and under a memory budget the
+	// target may turn out to need streaming
+	var prefetched *prefetchedFile
+	if !nmapXML && !stream && checkpointFile == "" && memoryBudget == 0 && len(files) > 0 {
+		prefetched = prefetch(files[0])
+	}
+
+	// Read the removal rules from the compiled patterns, pattern files and
+	// certificates, or stdin
+	var rules []*anot.Rule
+	var compiled *anot.CompiledPatterns
+	if compiledFile != "" && server != "" {
+		fail(usageError("-compiled and -server can't be combined"))
+		return
+	}
+	compiledSource := compiledFile
+	if compiledFile != "" {
+		var err error
+		if compiled, err = readCompiled(compiledFile); err != nil {
+			fail(patternsError(err))
+			return
+		}
+		rules = compiled.StoredRules()
+	} else if server != "" {
+		var err error
+		if compiled, err = serverPatterns(ctx, server, serverToken); err != nil {
+			fail(patternsError(err))
+			return
+		}
+		rules, compiledSource = compiled.StoredRules(), server
+	}
+	var spilled *diskSet
+	if len(patternFiles) > 0 || (len(fromCerts) == 0 && compiled == nil) {
+		var fileRules []*anot.Rule
+		var err error
+		spillBudget := exactBudget << 20
+		if spillBudget == 0 && memoryBudget > 0 {
+			spillBudget = int(memoryBudget / 2)
+		}
+		if spillBudget > 0 {
+			fileRules, spilled, err = loadRulesSpilling(patternFiles, extended, trim, spillBudget)
+		} else {
+			fileRules, err = loadRules(patternFiles, extended, trim)
+		}
+		if err != nil {
+			fail(patternsError(err))
+			return
+		}
+		rules = append(rules, fileRules...)
+	}
+	if spilled != nil {
+		defer spilled.Close()
+	}
+	for _, source := range fromCerts {
+		certPatterns, err := certRules(ctx, source)
+		if err != nil {
+			fail(patternsError(fmt.Errorf("failed to load certificate: %s", err)))
+			return
+		}
+		rules = append(rules, certPatterns...)
+	}
+
+	cleanRules(rules, clean)
+
+	// Categorize and pre-compile the rules by pattern type
+	if removeCDN || cdnRanges != "" {
+		extra, err := cdnRules(cdnRanges)
+		if err != nil {
+			fail(patternsError(fmt.Errorf("failed to read CDN ranges: %s", err)))
+			return
+		}
+		rules = append(rules, extra...)
+	}
+
+	providers := make(map[string]Enricher)
+	for _, spec := range enrichSpecs {
+		name, e, err := newEnricher(spec)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		providers[name] = e
+	}
+	var predicates []*enrichPredicate
+	if len(providers) > 0 {
+		rules, predicates = splitPredicates(rules, providers)
+	}
+	plugins := make(map[string]*pluginCheck)
+	var pluginNames []string
+	for _, spec := range pluginSpecs {
+		p, err := newPlugin(spec)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		if plugins[p.name] != nil {
+			fail(usageError("plugin %s given twice", p.name))
+			return
+		}
+		plugins[p.name] = p
+		pluginNames = append(pluginNames, p.name)
+	}
+	if len(plugins) > 0 {
+		rules = splitPluginPatterns(rules, plugins)
+	}
+	var whoisPatterns []*whoisPattern
+	rules, whoisPatterns = splitWhoisPatterns(rules)
+	if offline && len(whoisPatterns) > 0 {
+		fail(guardError("--offline forbids network features: whois-org: patterns"))
+		return
+	}
+
+	// Compiled patterns are used as is unless other patterns were added
+	var matcher *anot.Matcher
+	if compiled != nil && compiled.Hash == anot.RulesHash(rules) {
+		var err error
+		if matcher, err = compiled.Matcher(rules); err != nil {
+			fail(patternsError(fmt.Errorf("%s: %s", compiledSource, err)))
+			return
+		}
+	} else if compileCache {
+		var err error
+		if matcher, err = cachedMatcher(rules); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to update the compile cache: %s\n", err)
+		}
+	} else {
+		matcher = anot.NewMatcher(rules)
+	}
+	if useBloom {
+		matcher.EnableBloom()
+	}
+	if spilled != nil {
+		matcher.SetExactSet(spilled)
+	}
+	if hooks != nil {
+		matcher.SetHooks(hooks)
+	}
+	if asnDBFile != "" {
+		db, err := openASNDatabase(asnDBFile)
+		if err != nil {
+			fail(ioError(asnDBFile, fmt.Errorf("failed to read ASN database: %s", err)))
+			return
+		}
+		matcher.SetASNDatabase(db)
+	} else if matcher.HasASNPatterns() {
+		fail(usageError("asn: patterns need an -asn-db database"))
+		return
+	}
+
+	// Hosts are picked out of cleaned up URLs that still have a scheme
+	keyCleanup := cleanup
+	keyCleanup.anyScheme = false
+
+	// Select which part of each line is matched, the whole line by default
+	job := &filterJob{
+		matcher:          matcher,
+		ctx:              ctx,
+		concurrency:      concurrency,
+		ioConcurrency:    ioConcurrency,
+		emailMode:        emailMode,
+		urlMode:          cleanup.urls,
+		anyScheme:        cleanup.anyScheme,
+		ignoreZones:      ignoreZones,
+		clean:            keyCleanup.cleaner(),
+		trim:             trim,
+		csvMode:          csvMode,
+		csvColumn:        csvColumn,
+		hostsMode:        hostsMode,
+		hostsWholeLine:   hostsWholeLine,
+		zoneMode:         zoneMode,
+		zoneOrigin:       zoneOrigin,
+		nmapXML:          nmapXML,
+		keepMatched:      keepMatched,
+		minCount:         minCount,
+		maxCount:         maxCount,
+		withComments:     withComments,
+		dedupe:           dedupe,
+		sortOrder:        sortOrder,
+		unique:           unique,
+		reportDupes:      reportDupes,
+		summarizeRemoved: summarizeRemoved,
+		report:           report,
+		quietMode:        quietMode,
+		dryRun:           dryRun,
+		moveTo:           moveTo,
+		webhook:          webhook,
+		gitCommit:        gitCommit,
+		writeBuffer:      writeBuffer << 10,
+		stream:           stream || checkpointFile != "",
+		prefetched:       prefetched,
+		progress:         !noProgress && !quietMode,
+		checkpoint:       checkpointFile,
+	}
+	if memoryBudget > 0 {
+		// What the patterns leave of the budget is shared by the files in flight
+		left := memoryBudget - heapInUse()
+		if left <= 0 {
+			fmt.Fprintf(os.Stderr, "warning: the patterns alone take more than -max-memory\n")
+			left = 1
+		}
+		inFlight := ioConcurrency
+		if inFlight > len(files) {
+			inFlight = len(files)
+		}
+		job.fileBudget = left / int64(inFlight)
+	}
+	if field > 0 {
+		job.keys = fieldKey(field, delim)
+	}
+	if jsonPath != "" {
+		steps, err := parseJSONPath(jsonPath)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		job.keys = jsonKey(steps)
+	}
+	if extract != "" {
+		var err error
+		job.keys, err = extractKey(extract)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+	}
+	if preset != "" {
+		p, err := lookupPreset(preset)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		job.keys, job.fixup, job.match = p.Keys, p.Fixup, p.Match
+	}
+	if csvMode {
+		if csvColumn == "" {
+			fail(usageError("-csv needs -column"))
+			return
+		}
+		comma, err := csvComma(delim)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		job.csvComma = comma
+	}
+
+	// Set up the network based checks, shared by every file
+	dns := newDNSClient(dnsTimeout)
+	dns.retries = dnsRetries
+	if resolversFile != "" {
+		specs, err := readResolverFile(resolversFile)
+		if err != nil {
+			fail(ioError(resolversFile, fmt.Errorf("failed to read resolvers: %s", err)))
+			return
+		}
+		resolvers = append(resolvers, specs...)
+	}
+	if err := dns.setUpstreams(resolvers, resolverRate); err != nil {
+		fail(usageError("%s", err))
+		return
+	}
+	var cache *diskCache
+	if useCache || cacheFile != "" {
+		if cacheFile == "" {
+			path, err := defaultCachePath()
+			if err != nil {
+				fail(generalError(err))
+				return
+			}
+			cacheFile = path
+		}
+		c, err := openCache(cacheFile, cacheTTL)
+		if err != nil {
+			fail(ioError(cacheFile, fmt.Errorf("failed to open cache: %s", err)))
+			return
+		}
+		cache = c
+		dns.cache = cache
+	}
+	if resolve {
+		job.checks = append(job.checks, &resolveCheck{dns: dns, matcher: matcher})
+	}
+	if ptr {
+		job.checks = append(job.checks, &ptrCheck{dns: dns, matcher: matcher})
+	}
+	if pruneDead {
+		job.checks = append(job.checks, &pruneCheck{dns: dns})
+	}
+	if wildcardDNS {
+		job.checks = append(job.checks, newWildcardCheck(dns))
+	}
+	if aliveSpec != "" {
+		alive, err := newAliveCheck(aliveSpec, probeTimeout)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		job.checks = append(job.checks, alive)
+	}
+	if geoSpec != "" {
+		if mmdbFile == "" {
+			fail(usageError("-geoip needs a -mmdb database"))
+			return
+		}
+		db, err := openMMDB(mmdbFile)
+		if err != nil {
+			fail(ioError(mmdbFile, err))
+			return
+		}
+		geo, err := newGeoCheck(geoSpec, db)
+		if err != nil {
+			fail(usageError("%s", err))
+			return
+		}
+		job.checks = append(job.checks, geo)
+	}
+	if len(predicates) > 0 {
+		job.checks = append(job.checks, &enrichCheck{providers: providers, preds: predicates, cache: cache})
+	}
+	for _, name := range pluginNames {
+		p := plugins[name]
+		if len(p.rules) == 0 {
+			continue
+		}
+		if err := p.start(); err != nil {
+			fail(generalError(err))
+			return
+		}
+		defer p.stop()
+		job.checks = append(job.checks, p)
+	}
+	if len(whoisPatterns) > 0 {
+		job.checks = append(job.checks, &whoisCheck{patterns: whoisPatterns, cache: cache})
+	}
+
+	if job.stream {
+		if conflicts := streamConflicts(job); len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			fail(usageError("-stream can't be combined with %s", strings.Join(conflicts, ", ")))
+			return
+		}
+		if checkpointFile != "" && len(files) > 1 {
+			fail(usageError("-checkpoint works with a single file"))
+			return
+		}
+	}
+
+	if job.nmapXML {
+		if conflicts := nmapConflicts(job); len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			fail(usageError("-nmap-xml can't be combined with %s", strings.Join(conflicts, ", ")))
+			return
+		}
+	}
+
+	if follow != nil {
+		if conflicts := streamConflicts(job); len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			fail(usageError("-follow-stdin can't be combined with %s", strings.Join(conflicts, ", ")))
+			return
+		}
+		if err := job.follow(os.Stdin, os.Stdout, follow, reloadInterval, useBloom); err != nil {
+			fail(ioError("", err))
+		}
+	} else {
+		stats.addFiles(files)
+		if err := job.runFiles(files, os.Stdout, os.Stderr); err != nil {
+			status = exitStatus(err)
+		}
+		if len(files) > 1 {
+			progress := fmt.Sprintf("%d of %d files filtered, the others unchanged", atomic.LoadInt32(&job.filtered), len(files))
+			if stopped := stoppedError(ctx, progress); stopped != nil {
+				writeError(os.Stderr, stopped)
+			}
+		}
+		job.commitRemovals(os.Stderr)
+	}
+
+	if err := cache.save(); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to save cache: %s\n", err)
+	}
+	if showStats {
+		stats.write(os.Stderr)
+	}
+}
+
+// isCommentLine reports whether a line of the target file is a "#" comment
+func isCommentLine(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "#")
+}
+
+// runNmapXML filters an nmap XML file as a whole document rather than line by line
+func runNmapXML(fn string, matcher *anot.Matcher, stdout io.Writer, quietMode, dryRun bool) error {
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+	filtered, _, err := filterNmapXML(data, matcher)
+	if err != nil {
+		return fmt.Errorf("failed to parse nmap xml: %s", err)
+	}
+
+	if !quietMode {
+		stdout.Write(filtered)
+	}
+	if !dryRun {
+		return writeInPlace(fn, func(w io.Writer) error {
+			_, err := w.Write(filtered)
+			return err
+		})
+	}
+	return nil
+}
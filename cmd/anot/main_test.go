@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestMain runs anot itself instead of the tests when runAnot asks for it,
+// so the tests can check what a whole run does, exit status included
+func TestMain(m *testing.M) {
+	if os.Getenv("ANOT_TEST_MAIN") == "1" {
+		os.Args = append([]string{"anot"}, os.Args[1:]...)
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runAnot runs anot with args in dir, stdin read from stdin, and returns
+// its exit status and what it wrote to stderr
+func runAnot(t *testing.T, dir, stdin string, args ...string) (int, string) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "ANOT_TEST_MAIN=1")
+	cmd.Stdin = strings.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), stderr.String()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return 0, stderr.String()
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// inMemoryFactor is about how many times its size a file filtered in memory
+// peaks at: its contents, the line headers, the match results and the
+// output, plus the garbage collector's headroom
+const inMemoryFactor = 8
+
+// parseSize parses a byte size such as 2G, 512M or 1.5GB; suffixes are
+// binary multiples and a bare number is bytes
+func parseSize(s string) (int64, error) {
+	num := strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(s)), "B")
+	mult := int64(1)
+	if n := len(num); n > 0 {
+		switch num[n-1] {
+		case 'K':
+			mult = 1 << 10
+		case 'M':
+			mult = 1 << 20
+		case 'G':
+			mult = 1 << 30
+		case 'T':
+			mult = 1 << 40
+		}
+		if mult > 1 {
+			num = num[:n-1]
+		}
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(v * float64(mult)), nil
+}
+
+// heapInUse returns the bytes the heap holds after a collection, which is
+// what the loaded patterns cost once loading garbage is gone
+func heapInUse() int64 {
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return int64(ms.HeapAlloc)
+}
+
+// exceedsBudget reports whether filtering fn in memory would likely take
+// more than the job's per-file share of --max-memory
+func (j *filterJob) exceedsBudget(fn string) bool {
+	if j.fileBudget <= 0 {
+		return false
+	}
+	info, err := os.Stat(fn)
+	if err != nil {
+		// Reading it fails the same either way
+		return false
+	}
+	return info.Size()*inMemoryFactor > j.fileBudget
+}
+
+// streamForBudget reports whether fn should be streamed to stay within
+// --max-memory, warning when options needing the whole file prevent it
+func (j *filterJob) streamForBudget(fn string, stderr io.Writer) bool {
+	if j.stream || !j.exceedsBudget(fn) {
+		return false
+	}
+	if conflicts := streamConflicts(j); len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		fmt.Fprintf(stderr, "warning: %s may not fit in -max-memory, but can't be streamed with %s\n", fn, strings.Join(conflicts, ", "))
+		return false
+	}
+	fmt.Fprintf(stderr, "streaming %s to stay within -max-memory\n", fn)
+	return true
+}
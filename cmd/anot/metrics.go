@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// patternTypes are the kinds of patterns removals are counted by, in the
+// order the matcher tells them apart
+var patternTypes = [...]string{"expr", "wildcard", "cidr", "asn", "oui", "mac", "ip", "exact", "empty"}
+
+// latencyBuckets are the upper bounds in seconds of the latency histograms
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 30}
+
+// metrics are the counters of a daemon or server, exposed on /metrics in
+// the Prometheus text format. A nil *metrics counts nothing.
+type metrics struct {
+	checked  int64
+	removed  [len(patternTypes)]int64
+	patterns int64
+	reloads  int64
+	failed   int64
+
+	mu      sync.Mutex
+	latency map[string]*histogram
+}
+
+// histogram counts observations per latencyBuckets bound
+type histogram struct {
+	counts []int64
+	count  int64
+	sum    float64
+}
+
+func newMetrics() *metrics {
+	return &metrics{latency: make(map[string]*histogram)}
+}
+
+// line counts a checked line and, if its rule removed it, the removal
+func (m *metrics) line(rule *anot.Rule, kept bool) {
+	if m == nil {
+		return
+	}
+	atomic.AddInt64(&m.checked, 1)
+	if rule == nil || kept {
+		return
+	}
+	kind := rule.Type()
+	for i, t := range patternTypes {
+		if t == kind {
+			atomic.AddInt64(&m.removed[i], 1)
+		}
+	}
+}
+
+// loaded records a pattern load, err telling whether it failed; the first
+// load is not a reload
+func (m *metrics) loaded(patterns int, err error, reload bool) {
+	if m == nil {
+		return
+	}
+	switch {
+	case err != nil && reload:
+		atomic.AddInt64(&m.failed, 1)
+	case err == nil:
+		atomic.StoreInt64(&m.patterns, int64(patterns))
+		if reload {
+			atomic.AddInt64(&m.reloads, 1)
+		}
+	}
+}
+
+// observe records how long a request to handler took
+func (m *metrics) observe(handler string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.latency[handler]
+	if h == nil {
+		h = &histogram{counts: make([]int64, len(latencyBuckets))}
+		m.latency[handler] = h
+	}
+	s := d.Seconds()
+	for i, bound := range latencyBuckets {
+		if s <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += s
+}
+
+// timed wraps an HTTP handler to observe its latency
+func (m *metrics) timed(handler string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next(w, r)
+		m.observe(handler, time.Since(start))
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.write(w)
+}
+
+func (m *metrics) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP anot_lines_checked_total Lines checked against the patterns.\n")
+	fmt.Fprintf(w, "# TYPE anot_lines_checked_total counter\n")
+	fmt.Fprintf(w, "anot_lines_checked_total %d\n", atomic.LoadInt64(&m.checked))
+	fmt.Fprintf(w, "# HELP anot_lines_removed_total Lines removed, by the type of the pattern that matched.\n")
+	fmt.Fprintf(w, "# TYPE anot_lines_removed_total counter\n")
+	for i, t := range patternTypes {
+		fmt.Fprintf(w, "anot_lines_removed_total{type=%q} %d\n", t, atomic.LoadInt64(&m.removed[i]))
+	}
+	fmt.Fprintf(w, "# HELP anot_patterns Patterns loaded.\n")
+	fmt.Fprintf(w, "# TYPE anot_patterns gauge\n")
+	fmt.Fprintf(w, "anot_patterns %d\n", atomic.LoadInt64(&m.patterns))
+	fmt.Fprintf(w, "# HELP anot_pattern_reloads_total Pattern reloads, by outcome.\n")
+	fmt.Fprintf(w, "# TYPE anot_pattern_reloads_total counter\n")
+	fmt.Fprintf(w, "anot_pattern_reloads_total{result=\"ok\"} %d\n", atomic.LoadInt64(&m.reloads))
+	fmt.Fprintf(w, "anot_pattern_reloads_total{result=\"error\"} %d\n", atomic.LoadInt64(&m.failed))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	handlers := make([]string, 0, len(m.latency))
+	for handler := range m.latency {
+		handlers = append(handlers, handler)
+	}
+	sort.Strings(handlers)
+	fmt.Fprintf(w, "# HELP anot_request_duration_seconds Time taken to answer requests, by handler.\n")
+	fmt.Fprintf(w, "# TYPE anot_request_duration_seconds histogram\n")
+	for _, handler := range handlers {
+		h := m.latency[handler]
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(w, "anot_request_duration_seconds_bucket{handler=%q,le=\"%g\"} %d\n", handler, bound, h.counts[i])
+		}
+		fmt.Fprintf(w, "anot_request_duration_seconds_bucket{handler=%q,le=\"+Inf\"} %d\n", handler, h.count)
+		fmt.Fprintf(w, "anot_request_duration_seconds_sum{handler=%q} %g\n", handler, h.sum)
+		fmt.Fprintf(w, "anot_request_duration_seconds_count{handler=%q} %d\n", handler, h.count)
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+)
+
+// mmdbMetadataMarker starts the metadata section at the end of a MaxMind DB
+var mmdbMetadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// mmdbReader looks addresses up in a MaxMind DB file (GeoLite2, ASN and
+// any other database in the MaxMind DB format), read fully into memory
+type mmdbReader struct {
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	// dataStart is where the data section begins, after the search tree
+	dataStart uint
+	// databaseType is informational, e.g. GeoLite2-Country
+	databaseType string
+}
+
+// openMMDB reads and validates a MaxMind DB file
+func openMMDB(fn string) (*mmdbReader, error) {
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	at := bytes.LastIndex(data, mmdbMetadataMarker)
+	if at < 0 {
+		return nil, fmt.Errorf("%s: not a MaxMind DB file", fn)
+	}
+
+	meta := &mmdbDecoder{buf: data[at+len(mmdbMetadataMarker):]}
+	v, _, err := meta.decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("%s: bad metadata: %s", fn, err)
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: bad metadata", fn)
+	}
+
+	r := &mmdbReader{data: data}
+	r.nodeCount = uint(mmdbUint(m["node_count"]))
+	r.recordSize = uint(mmdbUint(m["record_size"]))
+	r.ipVersion = uint(mmdbUint(m["ip_version"]))
+	r.databaseType, _ = m["database_type"].(string)
+	switch r.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("%s: unsupported record size %d", fn, r.recordSize)
+	}
+	treeSize := r.nodeCount * r.recordSize / 4
+	r.dataStart = treeSize + 16
+	if r.dataStart > uint(at) {
+		return nil, fmt.Errorf("%s: truncated search tree", fn)
+	}
+	return r, nil
+}
+
+// lookup returns the record of the network containing ip, or nil
+func (r *mmdbReader) lookup(ip net.IP) (interface{}, error) {
+	addr := ip.To4()
+	if addr != nil && r.ipVersion == 6 {
+		// IPv4 lives in the ::/96 subtree of IPv6 databases
+		addr = append(make(net.IP, 12), addr...)
+	} else if addr == nil {
+		if r.ipVersion != 6 {
+			return nil, nil
+		}
+		addr = ip.To16()
+	}
+
+	node := uint(0)
+	for i := 0; i < len(addr)*8 && node < r.nodeCount; i++ {
+		bit := (addr[i/8] >> (7 - uint(i%8))) & 1
+		var err error
+		if node, err = r.record(node, uint(bit)); err != nil {
+			return nil, err
+		}
+	}
+	if node == r.nodeCount {
+		return nil, nil
+	}
+	if node < r.nodeCount {
+		return nil, errors.New("corrupt search tree")
+	}
+
+	offset := node - r.nodeCount - 16
+	d := &mmdbDecoder{buf: r.data[r.dataStart:]}
+	v, _, err := d.decode(offset)
+	return v, err
+}
+
+// record reads the left (bit 0) or right (bit 1) record of a tree node
+func (r *mmdbReader) record(node, bit uint) (uint, error) {
+	size := r.recordSize / 4
+	base := node * size
+	if base+size > uint(len(r.data)) {
+		return 0, errors.New("corrupt search tree")
+	}
+	b := r.data[base : base+size]
+	switch r.recordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2]), nil
+	case 28:
+		if bit == 0 {
+			return uint(b[3]&0xf0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2]), nil
+		}
+		return uint(b[3]&0x0f)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6]), nil
+	default:
+		return uint(binary.BigEndian.Uint32(b[bit*4:])), nil
+	}
+}
+
+// mmdbDecoder decodes the MaxMind DB data section format into maps,
+// slices, strings, numbers and booleans
+type mmdbDecoder struct {
+	buf []byte
+}
+
+// Data types of the MaxMind DB format
+const (
+	mmdbExtended = iota
+	mmdbPointer
+	mmdbString
+	mmdbDouble
+	mmdbBytes
+	mmdbUint16
+	mmdbUint32
+	mmdbMap
+	mmdbInt32
+	mmdbUint64
+	mmdbUint128
+	mmdbArray
+	mmdbContainer
+	mmdbEndMarker
+	mmdbBool
+	mmdbFloat
+)
+
+var errMMDBTruncated = errors.New("truncated data section")
+
+// mmdbMaxDepth bounds the nesting of maps and arrays, which a corrupt or
+// hostile file could otherwise make recurse until the stack runs out
+const mmdbMaxDepth = 512
+
+// decode decodes the value at offset and returns it with the offset of
+// the next value
+func (d *mmdbDecoder) decode(offset uint) (interface{}, uint, error) {
+	return d.decodeDepth(offset, 0, true)
+}
+
+// decodeDepth decodes the value at offset, nested depth levels deep; a
+// pointer is followed only when follow is set, as a pointer may not point
+// to another pointer
+func (d *mmdbDecoder) decodeDepth(offset uint, depth int, follow bool) (interface{}, uint, error) {
+	if depth > mmdbMaxDepth {
+		return nil, 0, errors.New("data nested too deeply")
+	}
+	if offset >= uint(len(d.buf)) {
+		return nil, 0, errMMDBTruncated
+	}
+	ctrl := d.buf[offset]
+	offset++
+	typ := uint(ctrl >> 5)
+
+	if typ == mmdbPointer {
+		if !follow {
+			return nil, 0, errors.New("pointer to a pointer")
+		}
+		target, next, err := d.pointer(ctrl, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, _, err := d.decodeDepth(target, depth, false)
+		return v, next, err
+	}
+
+	if typ == mmdbExtended {
+		if offset >= uint(len(d.buf)) {
+			return nil, 0, errMMDBTruncated
+		}
+		typ = 7 + uint(d.buf[offset])
+		offset++
+	}
+
+	size := uint(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		if offset+n > uint(len(d.buf)) {
+			return nil, 0, errMMDBTruncated
+		}
+		ext := uint(0)
+		for _, b := range d.buf[offset : offset+n] {
+			ext = ext<<8 | uint(b)
+		}
+		offset += n
+		switch n {
+		case 1:
+			size = 29 + ext
+		case 2:
+			size = 285 + ext
+		default:
+			size = 65821 + ext
+		}
+	}
+
+	switch typ {
+	case mmdbMap:
+		m := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			k, next, err := d.decodeDepth(offset, depth+1, true)
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, 0, errors.New("map key is not a string")
+			}
+			v, next, err := d.decodeDepth(next, depth+1, true)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key] = v
+			offset = next
+		}
+		return m, offset, nil
+	case mmdbArray:
+		a := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			v, next, err := d.decodeDepth(offset, depth+1, true)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+			offset = next
+		}
+		return a, offset, nil
+	case mmdbBool:
+		return size != 0, offset, nil
+	case mmdbContainer, mmdbEndMarker:
+		return nil, offset, nil
+	}
+
+	if offset+size > uint(len(d.buf)) {
+		return nil, 0, errMMDBTruncated
+	}
+	b := d.buf[offset : offset+size]
+	offset += size
+
+	switch typ {
+	case mmdbString:
+		return string(b), offset, nil
+	case mmdbBytes:
+		return append([]byte(nil), b...), offset, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, errors.New("bad double size")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, errors.New("bad float size")
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), offset, nil
+	case mmdbInt32:
+		n := uint32(0)
+		for _, c := range b {
+			n = n<<8 | uint32(c)
+		}
+		return int64(int32(n)), offset, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64:
+		n := uint64(0)
+		for _, c := range b {
+			n = n<<8 | uint64(c)
+		}
+		return n, offset, nil
+	case mmdbUint128:
+		// Rare and never needed for matching, kept as raw bytes
+		return append([]byte(nil), b...), offset, nil
+	default:
+		return nil, 0, fmt.Errorf("unknown data type %d", typ)
+	}
+}
+
+// pointer decodes a pointer whose control byte is ctrl and returns the
+// offset it points to and the offset following the pointer itself
+func (d *mmdbDecoder) pointer(ctrl byte, offset uint) (uint, uint, error) {
+	n := uint(ctrl>>3)&3 + 1
+	if offset+n > uint(len(d.buf)) {
+		return 0, 0, errMMDBTruncated
+	}
+	p := uint(0)
+	if n < 4 {
+		p = uint(ctrl & 7)
+	}
+	for _, b := range d.buf[offset : offset+n] {
+		p = p<<8 | uint(b)
+	}
+	switch n {
+	case 2:
+		p += 2048
+	case 3:
+		p += 526336
+	}
+	return p, offset + n, nil
+}
+
+// mmdbUint returns a decoded unsigned number, or 0
+func mmdbUint(v interface{}) uint64 {
+	n, _ := v.(uint64)
+	return n
+}
+
+// mmdbPath follows map keys into a decoded record, e.g. country, iso_code
+func mmdbPath(v interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
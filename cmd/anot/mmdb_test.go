@@ -0,0 +1,132 @@
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mmdbStr, mmdbUint32Value and mmdbMapHeader encode the data section values the tests use
+func mmdbStr(s string) []byte {
+	return append([]byte{mmdbString<<5 | byte(len(s))}, s...)
+}
+
+func mmdbUint32Value(n uint32) []byte {
+	return []byte{mmdbUint32<<5 | 4, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}
+}
+
+func mmdbMapHeader(n int) []byte {
+	return []byte{mmdbMap<<5 | byte(n)}
+}
+
+// writeTestMMDB writes an IPv4 database with a single one-node search tree:
+// 0.0.0.0/1 holds record and 128.0.0.0/1 holds nothing
+func writeTestMMDB(t *testing.T, record []byte) string {
+	t.Helper()
+	var b []byte
+	// One node of two 24-bit records: data at offset 0, and "no data"
+	b = append(b, 0, 0, 1+16, 0, 0, 1)
+	b = append(b, make([]byte, 16)...)
+	b = append(b, record...)
+	b = append(b, mmdbMetadataMarker...)
+	b = append(b, mmdbMapHeader(4)...)
+	b = append(b, mmdbStr("node_count")...)
+	b = append(b, mmdbUint32Value(1)...)
+	b = append(b, mmdbStr("record_size")...)
+	b = append(b, mmdbUint32Value(24)...)
+	b = append(b, mmdbStr("ip_version")...)
+	b = append(b, mmdbUint32Value(4)...)
+	b = append(b, mmdbStr("database_type")...)
+	b = append(b, mmdbStr("Test-ASN")...)
+
+	fn := filepath.Join(t.TempDir(), "test.mmdb")
+	if err := os.WriteFile(fn, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return fn
+}
+
+func TestMMDBLookup(t *testing.T) {
+	var record []byte
+	record = append(record, mmdbMapHeader(1)...)
+	record = append(record, mmdbStr("country")...)
+	record = append(record, mmdbMapHeader(1)...)
+	record = append(record, mmdbStr("iso_code")...)
+	record = append(record, mmdbStr("NL")...)
+	fn := writeTestMMDB(t, record)
+
+	db, err := openMMDB(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.databaseType != "Test-ASN" {
+		t.Errorf("database type = %q", db.databaseType)
+	}
+	v, err := db.lookup(net.ParseIP("10.0.0.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := mmdbPath(v, "country", "iso_code"); code != "NL" {
+		t.Errorf("country = %v, want NL", code)
+	}
+	if v, err := db.lookup(net.ParseIP("192.0.2.1")); err != nil || v != nil {
+		t.Errorf("lookup outside the data = %v, %v", v, err)
+	}
+	if v, err := db.lookup(net.ParseIP("2001:db8::1")); err != nil || v != nil {
+		t.Errorf("IPv6 lookup in an IPv4 database = %v, %v", v, err)
+	}
+}
+
+func TestOpenMMDBRejects(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "bad.mmdb")
+	if err := os.WriteFile(fn, []byte("no metadata here"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openMMDB(fn); err == nil {
+		t.Error("opened a file without metadata")
+	}
+}
+
+func TestMMDBDecode(t *testing.T) {
+	// Arrays are an extended type, 7 + 4
+	nested := strings.Repeat("\x01\x04", mmdbMaxDepth+1) + "\x41x"
+	tests := []struct {
+		name string
+		buf  string
+		want interface{}
+		err  string
+	}{
+		{"string", "\x42hi", "hi", ""},
+		{"uint16", "\xa2\x01\x00", uint64(256), ""},
+		{"bool", "\x01\x07", true, ""},
+		{"map through a pointer", "\xe1\x41a\x20\x05\x41x", map[string]interface{}{"a": "x"}, ""},
+		{"array", "\x02\x04\x41x\x41y", []interface{}{"x", "y"}, ""},
+		{"pointer to a pointer", "\x20\x02\x20\x00", nil, "pointer to a pointer"},
+		{"pointer to itself", "\x20\x00", nil, "pointer to a pointer"},
+		{"cycle through a map", "\xe1\x41a\x20\x00", nil, "nested too deeply"},
+		{"deep arrays", nested, nil, "nested too deeply"},
+		{"truncated string", "\x45ab", nil, "truncated"},
+		{"non-string key", "\xe1\xa1\x01\x41x", nil, "not a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &mmdbDecoder{buf: []byte(tt.buf)}
+			v, _, err := d.decode(0)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v, tt.want) {
+				t.Errorf("decode = %#v, want %#v", v, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	"github.com/hasshido/anot"
+)
+
+// nmapHost holds the parts of an nmap <host> element matched against the patterns
+type nmapHost struct {
+	Addresses []struct {
+		Addr string `xml:"addr,attr"`
+	} `xml:"address"`
+	Hostnames []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"hostnames>hostname"`
+}
+
+// nmapConflicts returns the enabled options that work on lines and can't
+// be combined with --nmap-xml, which filters whole <host> elements
+func nmapConflicts(j *filterJob) []string {
+	var conflicts []string
+	for name, enabled := range map[string]bool{
+		"-k":                 j.keepMatched,
+		"-move-to":           j.moveTo != "",
+		"-webhook":           j.webhook != "",
+		"-git-commit":        j.gitCommit != "",
+		"-csv":               j.csvMode,
+		"-hosts-file":        j.hostsMode,
+		"-zone-file":         j.zoneMode,
+		"-dedupe":            j.dedupe,
+		"-sort":              j.sortOrder != "",
+		"-u":                 j.unique || j.reportDupes,
+		"-min-count":         j.minCount > 0,
+		"-max-count":         j.maxCount > 0,
+		"-summarize-removed": j.summarizeRemoved,
+		"-report":            j.report != "",
+		"online checks":      len(j.checks) > 0,
+	} {
+		if enabled {
+			conflicts = append(conflicts, name)
+		}
+	}
+	return conflicts
+}
+
+// filterNmapXML removes the <host> elements whose address or hostname
+// matches from nmap XML output. Everything else is copied byte for byte, so
+// the result keeps the original formatting and stays valid XML. It returns
+// the filtered document and the number of hosts removed.
+func filterNmapXML(data []byte, m *anot.Matcher) ([]byte, int, error) {
+	var out bytes.Buffer
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	// nmap declares no exotic charsets, but don't fail on one either
+	dec.CharsetReader = func(_ string, r io.Reader) (io.Reader, error) { return r, nil }
+
+	copied := 0
+	removed := 0
+	for {
+		start := int(dec.InputOffset())
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		el, ok := tok.(xml.StartElement)
+		if !ok || el.Name.Local != "host" {
+			continue
+		}
+
+		var host nmapHost
+		if err := dec.DecodeElement(&host, &el); err != nil {
+			return nil, 0, err
+		}
+		end := int(dec.InputOffset())
+
+		var keys []string
+		for _, a := range host.Addresses {
+			keys = append(keys, a.Addr)
+		}
+		for _, h := range host.Hostnames {
+			keys = append(keys, h.Name)
+		}
+		if m.MatchKeys(keys) == nil {
+			continue
+		}
+
+		// Drop the element together with its indentation and line break
+		for start > copied && (data[start-1] == ' ' || data[start-1] == '\t') {
+			start--
+		}
+		if end < len(data) && data[end] == '\n' {
+			end++
+		}
+		out.Write(data[copied:start])
+		copied = end
+		removed++
+	}
+	out.Write(data[copied:])
+	return out.Bytes(), removed, nil
+}
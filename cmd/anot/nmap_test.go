@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const nmapScan = `<?xml version="1.0"?>
+<nmaprun>
+<host><address addr="10.0.0.1" addrtype="ipv4"/></host>
+<host><address addr="10.0.0.2" addrtype="ipv4"/></host>
+</nmaprun>
+`
+
+func TestNmapConflicts(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "scan.xml")
+	if err := os.WriteFile(fn, []byte(nmapScan), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "scope.txt"), []byte("10.0.0.1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, flags := range [][]string{
+		{"-k"},
+		{"-move-to", "removed.txt"},
+		{"-webhook", "http://localhost:1/"},
+		{"-git-commit", "scrub"},
+	} {
+		t.Run(flags[0], func(t *testing.T) {
+			args := append([]string{"-nmap-xml", "-q", "-p", "scope.txt"}, flags...)
+			status, stderr := runAnot(t, dir, "", append(args, fn)...)
+			if status != 2 || !strings.Contains(stderr, flags[0]) {
+				t.Errorf("exit status %d, want 2; stderr:\n%s", status, stderr)
+			}
+			if data, _ := os.ReadFile(fn); string(data) != nmapScan {
+				t.Error("the file was changed")
+			}
+		})
+	}
+
+	if status, stderr := runAnot(t, dir, "", "-nmap-xml", "-q", "-p", "scope.txt", fn); status != 0 {
+		t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+	}
+	if data, _ := os.ReadFile(fn); strings.Contains(string(data), "10.0.0.1") || !strings.Contains(string(data), "10.0.0.2") {
+		t.Errorf("the file is\n%s", data)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hasshido/anot"
+)
+
+// onlineCheck is a matcher that needs lookups, over the network or in a
+// local database, to decide whether a line goes. Checks run for every
+// distinct key before the filtering pass, concurrently, so the pass itself
+// only consults the verdicts.
+type onlineCheck interface {
+	// wants reports whether the check applies to a key at all
+	wants(key string) bool
+	// check returns the rule removing lines with this key, or nil; lookups
+	// give up once ctx is done
+	check(ctx context.Context, key string) *anot.Rule
+}
+
+// runOnlineChecks evaluates the checks for every distinct key with a
+// bounded number of concurrent workers, and returns the rule matched for
+// each key that has one. The first check returning a rule wins. Once ctx
+// is done the keys left are not checked.
+func runOnlineChecks(ctx context.Context, checks []onlineCheck, keys []string, workers int, prog *progress) map[string]*anot.Rule {
+	if workers < 1 {
+		workers = 1
+	}
+
+	verdicts := make(map[string]*anot.Rule)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan string)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				for _, c := range checks {
+					if !c.wants(key) || ctx.Err() != nil {
+						continue
+					}
+					if rule := c.check(ctx, key); rule != nil {
+						mu.Lock()
+						verdicts[key] = rule
+						mu.Unlock()
+						break
+					}
+				}
+				prog.add(1)
+			}
+		}()
+	}
+
+	seen := make(map[string]bool, len(keys))
+	var distinct []string
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			distinct = append(distinct, key)
+		}
+	}
+	prog.start("looking up", "keys", int64(len(distinct)))
+	for _, key := range distinct {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- key
+	}
+	close(queue)
+	wg.Wait()
+	return verdicts
+}
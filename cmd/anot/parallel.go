@@ -0,0 +1,57 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/hasshido/anot"
+)
+
+// matchChunkSize is the number of lines a worker matches at a time; files
+// smaller than two chunks are matched on the calling goroutine
+const matchChunkSize = 4096
+
+// matchLines returns the rule matching each line, computed by a pool of
+// workers over fixed-size chunks. match must only read shared state, which
+// holds for the compiled matcher and the prefetched verdicts. Results are
+// stored by index, so they come back in the original order. Progress is
+// counted per chunk.
+func matchLines(lines []string, prog *progress, match func(i int, line string) *anot.Rule) []*anot.Rule {
+	rules := make([]*anot.Rule, len(lines))
+	prog.start("matching", "lines", int64(len(lines)))
+	workers := runtime.GOMAXPROCS(0)
+	if workers < 2 || len(lines) < 2*matchChunkSize {
+		for i, line := range lines {
+			rules[i] = match(i, line)
+			if (i+1)%matchChunkSize == 0 {
+				prog.add(matchChunkSize)
+			}
+		}
+		return rules
+	}
+
+	chunks := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := start + matchChunkSize
+				if end > len(lines) {
+					end = len(lines)
+				}
+				for i := start; i < end; i++ {
+					rules[i] = match(i, lines[i])
+				}
+				prog.add(end - start)
+			}
+		}()
+	}
+	for start := 0; start < len(lines); start += matchChunkSize {
+		chunks <- start
+	}
+	close(chunks)
+	wg.Wait()
+	return rules
+}
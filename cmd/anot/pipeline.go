@@ -0,0 +1,505 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hasshido/anot"
+)
+
+// filterJob holds what filtering a target file needs and is shared by all
+// files of a run: the compiled matcher and online checks, which part of
+// the lines is matched, and the output options. Running it only reads the
+// job, so several files can be filtered concurrently.
+type filterJob struct {
+	matcher *anot.Matcher
+	checks  []onlineCheck
+	// ctx stops the lookups, and the files not written yet, once done
+	ctx context.Context
+	// filtered counts the files filtered to the end
+	filtered    int32
+	concurrency int
+	// ioConcurrency is how many target files are worked on at once
+	ioConcurrency int
+
+	// keys selects the matched values of a line, nil for the whole line
+	keys  keyFunc
+	fixup func([]string) []string
+	// match combines the matches of the keys, see Preset.Match
+	match     func(m *anot.Matcher, keys []string) *anot.Rule
+	emailMode bool
+	// urlMode matches the IP hosts of URLs as well, and anyScheme every
+	// host, see urlKeys
+	urlMode   bool
+	anyScheme bool
+	// ignoreZones matches zoned IPv6 addresses without their zone as well
+	ignoreZones bool
+	// clean is the cleanup the matcher does to every value, but for
+	// dropping the scheme of URLs, done before picking the hosts and
+	// addresses out of the keys too
+	clean func(string) string
+	trim  bool
+
+	csvMode   bool
+	csvColumn string
+	csvComma  rune
+
+	hostsMode      bool
+	hostsWholeLine bool
+	zoneMode       bool
+	zoneOrigin     string
+	nmapXML        bool
+
+	keepMatched      bool
+	minCount         int
+	maxCount         int
+	withComments     bool
+	dedupe           bool
+	sortOrder        string
+	unique           bool
+	reportDupes      bool
+	summarizeRemoved bool
+	report           string
+
+	// stream filters line by line in bounded memory, see runStream
+	stream     bool
+	checkpoint string
+
+	// prefetched is a target file read ahead while the patterns loaded
+	prefetched *prefetchedFile
+	// progress draws a status line while a file is filtered, on terminals
+	progress bool
+
+	quietMode bool
+	dryRun    bool
+	moveTo    string
+	// webhook is the URL notified of every file lines are removed from
+	webhook string
+	// gitCommit is the message the removals are committed to git with,
+	// removals what was removed from each file
+	gitCommit  string
+	removals   map[string]int
+	removalsMu sync.Mutex
+	// fileBudget is the share of --max-memory a file filtered in memory
+	// may take, past which it is streamed; zero for no limit
+	fileBudget int64
+	// writeBuffer is the size of the buffers output is written through
+	writeBuffer int
+	// moveMu serializes appends to the shared --move-to file
+	moveMu sync.Mutex
+}
+
+// runFiles filters every file with a bounded number of files in flight.
+// A failing file is reported and does not stop the others; output and
+// reports of each file are written in the order the files were given.
+// The error of the first file failing is returned.
+func (j *filterJob) runFiles(files []string, stdout, stderr io.Writer) error {
+	if len(files) == 1 {
+		if err := j.run(files[0], stdout, stderr); err != nil {
+			err = fileError("", err)
+			writeError(stderr, err)
+			return err
+		}
+		atomic.AddInt32(&j.filtered, 1)
+		return nil
+	}
+	if j.stream {
+		// Buffering whole outputs would defeat streaming, go one by one
+		var failed error
+		for _, fn := range files {
+			if err := j.run(fn, stdout, stderr); err != nil {
+				err = fileError(fn, err)
+				writeError(stderr, err)
+				if failed == nil {
+					failed = err
+				}
+				continue
+			}
+			atomic.AddInt32(&j.filtered, 1)
+		}
+		return failed
+	}
+
+	type result struct {
+		out, errs *bytes.Buffer
+		err       error
+		done      chan struct{}
+	}
+	results := make([]*result, len(files))
+	for i := range results {
+		results[i] = &result{out: getBuffer(), errs: getBuffer(), done: make(chan struct{})}
+	}
+
+	queue := make(chan int)
+	workers := j.ioConcurrency
+	if workers > len(files) {
+		workers = len(files)
+	}
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range queue {
+				r := results[i]
+				if err := j.run(files[i], r.out, r.errs); err != nil {
+					r.err = fileError(files[i], err)
+					writeError(r.errs, r.err)
+				} else {
+					atomic.AddInt32(&j.filtered, 1)
+				}
+				close(r.done)
+			}
+		}()
+	}
+	go func() {
+		for i := range files {
+			queue <- i
+		}
+		close(queue)
+	}()
+
+	var failed error
+	for _, r := range results {
+		<-r.done
+		stderr.Write(r.errs.Bytes())
+		stdout.Write(r.out.Bytes())
+		putBuffer(r.errs)
+		putBuffer(r.out)
+		if failed == nil {
+			failed = r.err
+		}
+	}
+	return failed
+}
+
+// context returns the context the job runs in
+func (j *filterJob) context() context.Context {
+	if j.ctx == nil {
+		return context.Background()
+	}
+	return j.ctx
+}
+
+// prefetchedFile is a target file being read in the background
+type prefetchedFile struct {
+	fn    string
+	lines []string
+	err   error
+	done  chan struct{}
+	// taken makes sure only the first run of the file uses the lines
+	taken int32
+}
+
+// prefetch starts reading fn in the background; reading patterns and the
+// target are independent, so they need not wait for each other
+func prefetch(fn string) *prefetchedFile {
+	p := &prefetchedFile{fn: fn, done: make(chan struct{})}
+	go func() {
+		p.lines, p.err = readLines(fn)
+		close(p.done)
+	}()
+	return p
+}
+
+// readTarget returns the lines of a target file, read ahead if possible
+func (j *filterJob) readTarget(fn string) ([]string, error) {
+	if p := j.prefetched; p != nil && p.fn == fn && atomic.CompareAndSwapInt32(&p.taken, 0, 1) {
+		<-p.done
+		return p.lines, p.err
+	}
+	return readLines(fn)
+}
+
+// run filters one target file, printing the result to stdout unless quiet
+// and writing it back unless in dry-run mode
+func (j *filterJob) run(fn string, stdout, stderr io.Writer) error {
+	if err := stoppedError(j.ctx, "left unchanged"); err != nil {
+		return err
+	}
+	if j.nmapXML {
+		return runNmapXML(fn, j.matcher, stdout, j.quietMode, j.dryRun)
+	}
+	if j.stream || j.streamForBudget(fn, stderr) {
+		return j.runStream(fn, stdout, stderr)
+	}
+
+	var prog *progress
+	if j.progress {
+		prog = newProgress(stderr, fn)
+		prog.start("reading", "", 0)
+		defer prog.finish()
+	}
+
+	// Read the target file lines into a slice to preserve order
+	fileLines, err := j.readTarget(fn)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+	// Everything derived from the lines is written out before returning
+	defer putLines(fileLines)
+
+	// In CSV mode the header is set aside so it is never matched, sorted or deduplicated
+	keys := j.keys
+	var csvHeader []string
+	if j.csvMode {
+		fileLines = groupCSVRecords(fileLines)
+		if len(fileLines) > 0 {
+			csvHeader, fileLines = []string{fileLines[0]}, fileLines[1:]
+			keys, err = csvColumnKey(csvHeader[0], j.csvColumn, j.csvComma)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	keys = j.wrapKeys(keys)
+
+	trim := j.trim
+	matcher := j.matcher
+
+	// keysOf returns the values of a line matched against the patterns
+	keysOf := func(line string) []string {
+		if keys == nil {
+			if trim {
+				return []string{strings.TrimSpace(line)}
+			}
+			return []string{line}
+		}
+		lineKeys := keys(line)
+		if trim {
+			for i := range lineKeys {
+				lineKeys[i] = strings.TrimSpace(lineKeys[i])
+			}
+		}
+		return lineKeys
+	}
+
+	// In zone mode records are matched on their resolved owner name
+	var owners []string
+	if j.zoneMode {
+		fileLines = groupZoneRecords(fileLines)
+		owners = zoneOwners(fileLines, j.zoneOrigin)
+	}
+
+	// Count occurrences up front for the threshold modes
+	var occurrences map[string]int
+	countRule := &anot.Rule{Action: anot.ActionRemove}
+	if j.minCount > 0 || j.maxCount > 0 {
+		occurrences = make(map[string]int)
+		for _, line := range fileLines {
+			if trim {
+				line = strings.TrimSpace(line)
+			}
+			occurrences[line]++
+		}
+	}
+	outsideThresholds := func(key string) bool {
+		if occurrences == nil || key == "" || isCommentLine(key) {
+			return false
+		}
+		n := occurrences[key]
+		return (j.minCount > 0 && n < j.minCount) || (j.maxCount > 0 && n > j.maxCount)
+	}
+
+	// Run the network based checks for every distinct key up front
+	var verdicts map[string]*anot.Rule
+	if len(j.checks) > 0 && !j.zoneMode && !j.hostsMode {
+		var candidates []string
+		for _, line := range fileLines {
+			for _, key := range keysOf(line) {
+				// Lines matched offline need no lookups, nor do those
+				// --keep-if keeps
+				if matcher.Match(key) == nil && !matcher.Kept(key) {
+					candidates = append(candidates, key)
+				}
+			}
+		}
+		verdicts = runOnlineChecks(j.context(), j.checks, candidates, j.concurrency, prog)
+	}
+
+	// Filter the file lines, applying the action of the matching rule
+	var filteredLines []string
+	var removedLines []string
+	// Number of comment lines at the end of filteredLines that directly precede the current line
+	pendingComments := 0
+	// Surviving lines seen so far in dedupe mode; blank and comment lines are never deduplicated
+	seen := make(map[string]bool)
+	isDuplicate := func(key string) bool {
+		if !j.dedupe || key == "" || isCommentLine(key) {
+			return false
+		}
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return false
+	}
+	// Matching is read-only, so it runs in parallel up front; hosts files
+	// are rewritten line by line below instead
+	var lineRules []*anot.Rule
+	if !j.hostsMode {
+		lineRules = matchLines(fileLines, prog, func(i int, line string) *anot.Rule {
+			if j.zoneMode {
+				if owners[i] == "" {
+					return nil
+				}
+				return matcher.Match(owners[i])
+			}
+			if keys == nil {
+				// Whole-line matching needs no key slice per line
+				key := line
+				if trim {
+					key = strings.TrimSpace(line)
+				}
+				if rule := matcher.Match(key); rule != nil || verdicts == nil {
+					return rule
+				}
+				return verdicts[key]
+			}
+			lineKeys := keysOf(line)
+			rule := j.matchKeys(matcher, lineKeys)
+			if rule == nil && verdicts != nil {
+				for _, key := range lineKeys {
+					if rule = verdicts[key]; rule != nil {
+						break
+					}
+				}
+			}
+			return rule
+		})
+	}
+	for i, line := range fileLines {
+		checkLine := line
+		if trim {
+			checkLine = strings.TrimSpace(line)
+		}
+
+		var rule *anot.Rule
+		if j.hostsMode {
+			out, hostsRule, removed := filterHostsLine(line, matcher, trim, j.hostsWholeLine)
+			if removed != "" {
+				removedLines = append(removedLines, removed)
+			}
+			if hostsRule == nil {
+				filteredLines = append(filteredLines, out)
+				if isCommentLine(out) {
+					pendingComments++
+				} else {
+					pendingComments = 0
+				}
+				continue
+			}
+			rule = hostsRule
+		} else {
+			rule = lineRules[i]
+		}
+		if rule == nil && outsideThresholds(checkLine) {
+			rule = countRule
+		}
+		if j.keepMatched {
+			// Matched lines survive untouched, everything else goes
+			if rule != nil {
+				rule = nil
+			} else {
+				rule = countRule
+			}
+		}
+		if rule == nil {
+			if isDuplicate(checkLine) {
+				pendingComments = 0
+				continue
+			}
+			filteredLines = append(filteredLines, line)
+			if isCommentLine(line) {
+				pendingComments++
+			} else {
+				pendingComments = 0
+			}
+			continue
+		}
+		if out, keep := rule.Apply(line); keep {
+			if !isDuplicate(out) {
+				filteredLines = append(filteredLines, out)
+			}
+		} else {
+			removedLines = append(removedLines, line)
+			if j.withComments {
+				// Drop the comments documenting the removed entry
+				filteredLines = filteredLines[:len(filteredLines)-pendingComments]
+			}
+		}
+		pendingComments = 0
+	}
+
+	// Verdicts left out by a timeout or an interrupt would keep lines, so
+	// nothing is written; past this point the file is written to the end
+	if err := stoppedError(j.ctx, "left unchanged"); err != nil {
+		return err
+	}
+
+	if j.sortOrder != "" {
+		if err := sortLines(filteredLines, j.sortOrder, trim); err != nil {
+			return err
+		}
+	}
+
+	if j.unique || j.reportDupes {
+		var collapsed int
+		filteredLines, collapsed = uniqueLines(filteredLines, trim)
+		if j.reportDupes {
+			fmt.Fprintf(stderr, "collapsed %d duplicate lines\n", collapsed)
+		}
+	}
+
+	if j.summarizeRemoved {
+		var removedIPs []net.IP
+		for _, line := range removedLines {
+			if ip := net.ParseIP(strings.TrimSpace(line)); ip != nil {
+				removedIPs = append(removedIPs, ip)
+			}
+		}
+		for _, block := range summarizeIPs(removedIPs) {
+			fmt.Fprintln(stderr, block)
+		}
+	}
+
+	if j.report == "apex" {
+		writeApexReport(stderr, filteredLines)
+	}
+
+	filteredLines = append(csvHeader, filteredLines...)
+	if j.fixup != nil {
+		filteredLines = j.fixup(filteredLines)
+	}
+
+	prog.start("writing", "", 0)
+
+	// Output filtered lines to stdout if not in quiet mode
+	if !j.quietMode {
+		writeLines(stdout, filteredLines, j.writeBuffer)
+	}
+
+	// Write filtered lines back to file if not in dry-run mode
+	if !j.dryRun {
+		// Move removed lines first, so a failure never loses them
+		if j.moveTo != "" && len(removedLines) > 0 {
+			j.moveMu.Lock()
+			err := appendNewLines(j.moveTo, removedLines)
+			j.moveMu.Unlock()
+			if err != nil {
+				return fmt.Errorf("failed to move lines to %s: %s", j.moveTo, err)
+			}
+		}
+
+		if err := writeLinesInPlace(fn, filteredLines, j.writeBuffer); err != nil {
+			return fmt.Errorf("failed to write file: %s", err)
+		}
+	}
+	j.notifyRemovals(fn, len(removedLines), len(filteredLines)-len(csvHeader), removedLines, stderr)
+	j.recordRemovals(fn, len(removedLines))
+	return nil
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hasshido/anot"
+)
+
+// planVersion changes whenever the plan format does, so "anot apply"
+// refuses plans it would misread
+const planVersion = 1
+
+// removalPlan is what "anot plan" writes: for every file, the changes the
+// patterns make to it, and the hash of the content they were planned on
+type removalPlan struct {
+	Version int        `json:"version"`
+	Files   []filePlan `json:"files"`
+}
+
+type filePlan struct {
+	File    string       `json:"file"`
+	SHA256  string       `json:"sha256"`
+	Lines   int          `json:"lines"`
+	Changes []lineChange `json:"changes"`
+}
+
+// lineChange is what becomes of one line: it goes, or with an action of
+// the extended syntax it is replaced by output
+type lineChange struct {
+	Line    int     `json:"line"`
+	Text    string  `json:"text"`
+	Pattern string  `json:"pattern"`
+	Type    string  `json:"type"`
+	Action  string  `json:"action"`
+	Output  *string `json:"output,omitempty"`
+}
+
+func planFail(err error) {
+	writeError(os.Stderr, err)
+	os.Exit(exitStatus(err))
+}
+
+// runPlan implements "anot plan": the changes the patterns would make to
+// the files are written as a JSON plan, to be reviewed and then carried
+// out by "anot apply", and nothing else is touched
+func runPlan(args []string) {
+	fs := flag.NewFlagSet("anot plan", flag.ExitOnError)
+	var out string
+	var patternFiles stringList
+	var compiledFile string
+	var asnDBFile string
+	var trim bool
+	var extended bool
+	fs.StringVar(&out, "o", "", "write the plan to this file instead of stdout")
+	fs.Var(&patternFiles, "p", "read patterns from this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	fs.StringVar(&asnDBFile, "asn-db", "", "ASN database for asn:NUMBER patterns")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot plan -p patterns.txt [options] [-o plan.json] <filename>...\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		planFail(usageError("no filename provided"))
+	}
+
+	src := &patternSource{who: "anot plan", compiledFile: compiledFile, patternFiles: patternFiles,
+		asnDBFile: asnDBFile, extended: extended, trim: trim}
+	_, matcher, err := src.load()
+	if err != nil {
+		planFail(patternsError(err))
+	}
+
+	plan := removalPlan{Version: planVersion}
+	for _, fn := range fs.Args() {
+		fp, err := planFile(fn, matcher, trim)
+		if err != nil {
+			planFail(ioError(fn, err))
+		}
+		plan.Files = append(plan.Files, fp)
+	}
+
+	write := func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(plan)
+	}
+	if out == "" {
+		err = write(os.Stdout)
+	} else {
+		err = writeAtomic(out, write)
+	}
+	if err != nil {
+		planFail(ioError(out, err))
+	}
+}
+
+// planFile plans the changes to one file
+func planFile(fn string, matcher *anot.Matcher, trim bool) (filePlan, error) {
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return filePlan{}, err
+	}
+	sum := sha256.Sum256(data)
+	fp := filePlan{File: fn, SHA256: hex.EncodeToString(sum[:]), Changes: []lineChange{}}
+	err = anot.Decide(bytes.NewReader(data), matcher, anot.FilterOptions{Trim: trim, MaxLineSize: len(data) + 1}, func(d anot.Decision) error {
+		fp.Lines = d.N
+		if d.Rule == nil {
+			return nil
+		}
+		change := lineChange{Line: d.N, Text: d.Line, Pattern: d.Pattern, Type: d.Type, Action: d.Rule.Action.String()}
+		if !d.Removed {
+			if d.Output == d.Line {
+				return nil
+			}
+			output := d.Output
+			change.Output = &output
+		}
+		fp.Changes = append(fp.Changes, change)
+		return nil
+	})
+	return fp, err
+}
+
+// runApply implements "anot apply": the changes of a plan are made, and
+// only those, once every file was verified to be as it was planned on
+func runApply(args []string) {
+	fs := flag.NewFlagSet("anot apply", flag.ExitOnError)
+	var quietMode bool
+	fs.BoolVar(&quietMode, "q", false, "quiet mode (don't report the changes made)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot apply [options] plan.json\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		planFail(ioError(fs.Arg(0), err))
+	}
+	var plan removalPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		planFail(&kindError{kind: errUsage, file: fs.Arg(0), err: fmt.Errorf("not a plan: %s", err)})
+	}
+	if plan.Version != planVersion {
+		planFail(&kindError{kind: errUsage, file: fs.Arg(0), err: fmt.Errorf("plan format version %d, want %d", plan.Version, planVersion)})
+	}
+
+	// Every file is checked before any is written, so a stale plan
+	// changes nothing
+	results := make([][]string, len(plan.Files))
+	for i, fp := range plan.Files {
+		lines, err := applyFilePlan(fp)
+		if err != nil {
+			planFail(fileError(fp.File, err))
+		}
+		results[i] = lines
+	}
+	for i, fp := range plan.Files {
+		if len(fp.Changes) == 0 {
+			continue
+		}
+		if err := writeLinesInPlace(fp.File, results[i], defaultWriteBuffer); err != nil {
+			planFail(ioError(fp.File, fmt.Errorf("failed to write file: %s", err)))
+		}
+		if !quietMode {
+			fmt.Fprintf(os.Stderr, "%s: %d lines changed as planned\n", fp.File, len(fp.Changes))
+		}
+	}
+}
+
+// applyFilePlan returns the lines of a file with the changes of its plan
+// made, or an error if the file is no longer the one planned on
+func applyFilePlan(fp filePlan) ([]string, error) {
+	data, err := os.ReadFile(fp.File)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != fp.SHA256 {
+		return nil, guardError("changed since the plan was made; make the plan again")
+	}
+	lines := splitLines(string(data))
+	if len(lines) != fp.Lines {
+		return nil, errors.New("the plan doesn't fit the file")
+	}
+
+	out := make([]string, 0, len(lines))
+	next := 1
+	for _, c := range fp.Changes {
+		if c.Line < next || c.Line > len(lines) || lines[c.Line-1] != c.Text {
+			return nil, fmt.Errorf("the plan doesn't fit the file at line %d", c.Line)
+		}
+		out = append(out, lines[next-1:c.Line-1]...)
+		if c.Output != nil {
+			out = append(out, *c.Output)
+		}
+		next = c.Line + 1
+	}
+	return append(out, lines[next-1:]...), nil
+}
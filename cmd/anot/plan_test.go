@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+const planList = "a.example.com\nkeep.org\n10.0.0.1\nb.example.com\n"
+
+// makePlan writes list.txt and the patterns to dir and plans on them
+func makePlan(t *testing.T, dir string) removalPlan {
+	t.Helper()
+	for fn, data := range map[string]string{"oos.txt": "*.example.com\n10.0.0.1\n", "list.txt": planList} {
+		if err := os.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if status, stderr := runAnot(t, dir, "", "plan", "-p", "oos.txt", "-o", "plan.json", "list.txt"); status != 0 {
+		t.Fatalf("plan exit status %d; stderr:\n%s", status, stderr)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "plan.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plan removalPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		t.Fatal(err)
+	}
+	return plan
+}
+
+func writePlan(t *testing.T, dir string, plan removalPlan) {
+	t.Helper()
+	data, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "plan.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readList(t *testing.T, dir string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, "list.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestPlanApply(t *testing.T) {
+	dir := t.TempDir()
+	plan := makePlan(t, dir)
+	if len(plan.Files) != 1 || plan.Files[0].Lines != 4 {
+		t.Fatalf("plan %+v", plan)
+	}
+	var changes []string
+	for _, c := range plan.Files[0].Changes {
+		changes = append(changes, fmt.Sprintf("%d %s %s %s %s", c.Line, c.Text, c.Pattern, c.Type, c.Action))
+	}
+	want := []string{
+		"1 a.example.com *.example.com wildcard remove",
+		"3 10.0.0.1 10.0.0.1 ip remove",
+		"4 b.example.com *.example.com wildcard remove",
+	}
+	if strings.Join(changes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("changes\n%s\nwant\n%s", strings.Join(changes, "\n"), strings.Join(want, "\n"))
+	}
+	// Planning touches nothing
+	if got := readList(t, dir); got != planList {
+		t.Errorf("list.txt is %q after the plan", got)
+	}
+
+	if status, stderr := runAnot(t, dir, "", "apply", "-q", "plan.json"); status != 0 {
+		t.Fatalf("apply exit status %d; stderr:\n%s", status, stderr)
+	}
+	if got := readList(t, dir); got != "keep.org\n" {
+		t.Errorf("list.txt is %q after the apply", got)
+	}
+	// The file changed since, by the apply itself
+	if status, _ := runAnot(t, dir, "", "apply", "-q", "plan.json"); status != 5 {
+		t.Errorf("applying the plan twice: exit status %d, want 5", status)
+	}
+}
+
+func TestApplyRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, dir string, plan *removalPlan)
+		list   string
+		status int
+	}{
+		{"stale sha256", func(t *testing.T, dir string, plan *removalPlan) {
+			if err := os.WriteFile(filepath.Join(dir, "list.txt"), []byte(planList+"c.example.com\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}, planList + "c.example.com\n", 5},
+		{"line text mismatch", func(t *testing.T, dir string, plan *removalPlan) {
+			plan.Files[0].Changes[1].Text = "keep.org"
+		}, planList, 4},
+		{"line count mismatch", func(t *testing.T, dir string, plan *removalPlan) {
+			plan.Files[0].Lines++
+		}, planList, 4},
+		{"version", func(t *testing.T, dir string, plan *removalPlan) {
+			plan.Version++
+		}, planList, 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			plan := makePlan(t, dir)
+			test.change(t, dir, &plan)
+			writePlan(t, dir, plan)
+			if status, stderr := runAnot(t, dir, "", "apply", "-q", "plan.json"); status != test.status {
+				t.Errorf("exit status %d, want %d; stderr:\n%s", status, test.status, stderr)
+			}
+			if got := readList(t, dir); got != test.list {
+				t.Errorf("list.txt is %q, want it untouched", got)
+			}
+		})
+	}
+}
+
+func TestPlanFileIPSet(t *testing.T) {
+	// Enough IPs for a sorted IP set
+	rules := make([]*anot.Rule, 1<<16+1)
+	for i := range rules {
+		rules[i] = &anot.Rule{Pattern: fmt.Sprintf("10.%d.%d.1", i>>8, i&0xff)}
+	}
+	fn := filepath.Join(t.TempDir(), "list.txt")
+	if err := os.WriteFile(fn, []byte("example.com\n10.0.7.1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fp, err := planFile(fn, anot.NewMatcher(rules), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fp.Changes) != 1 {
+		t.Fatalf("changes %+v", fp.Changes)
+	}
+	if c := fp.Changes[0]; c.Line != 2 || c.Pattern != "10.0.7.1" || c.Type != "ip" {
+		t.Errorf("change %+v, want line 2 removed by 10.0.7.1", c)
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hasshido/anot"
+)
+
+// pluginCheck matches keys with an external program, for pattern types
+// anot doesn't know. The program is started once and speaks a line
+// protocol: it first reads its patterns, one per line up to an empty line,
+// then answers every key it reads with the number of the pattern matching
+// it, counted from 1, or 0. Answers come in the order of the keys, so
+// several keys can be in flight at once.
+type pluginCheck struct {
+	name    string
+	command []string
+	rules   []*anot.Rule
+
+	cmd *exec.Cmd
+	// mu keeps the keys written and the answers awaited in the same order
+	mu      sync.Mutex
+	in      *bufio.Writer
+	stdin   io.Closer
+	pending chan chan int
+	// done is closed once the answers are all read
+	done   chan struct{}
+	failed int32
+	once   sync.Once
+}
+
+// newPlugin parses a -plugin NAME=COMMAND specification
+func newPlugin(spec string) (*pluginCheck, error) {
+	name, command, ok := strings.Cut(spec, "=")
+	args := strings.Fields(command)
+	if !ok || name == "" || strings.ContainsAny(name, ": ") || len(args) == 0 {
+		return nil, fmt.Errorf("invalid plugin %q: want NAME=COMMAND", spec)
+	}
+	return &pluginCheck{name: name, command: args}, nil
+}
+
+// splitPluginPatterns takes the NAME:ARGUMENT patterns of the plugins out
+// of rules, giving each plugin its rules with the argument as the pattern
+func splitPluginPatterns(rules []*anot.Rule, plugins map[string]*pluginCheck) []*anot.Rule {
+	var rest []*anot.Rule
+	for _, rule := range rules {
+		name, arg, ok := strings.Cut(rule.Pattern, ":")
+		p := plugins[name]
+		if !ok || p == nil {
+			rest = append(rest, rule)
+			continue
+		}
+		r := *rule
+		r.Pattern = arg
+		p.rules = append(p.rules, &r)
+	}
+	return rest
+}
+
+// start runs the plugin and hands it its patterns
+func (p *pluginCheck) start() error {
+	p.cmd = exec.Command(p.command[0], p.command[1:]...)
+	p.cmd.Stderr = os.Stderr
+	stdin, err := p.cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := p.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := p.cmd.Start(); err != nil {
+		return fmt.Errorf("plugin %s: %s", p.name, err)
+	}
+	p.stdin, p.in = stdin, bufio.NewWriter(stdin)
+	p.pending = make(chan chan int, 1024)
+	p.done = make(chan struct{})
+	for _, rule := range p.rules {
+		p.in.WriteString(rule.Pattern)
+		p.in.WriteByte('\n')
+	}
+	p.in.WriteByte('\n')
+	if err := p.in.Flush(); err != nil {
+		return fmt.Errorf("plugin %s: %s", p.name, err)
+	}
+	go p.answers(stdout)
+	return nil
+}
+
+// answers hands the answers of the plugin to the keys awaiting them, in
+// order. Once the plugin failed, every key gets no match.
+func (p *pluginCheck) answers(stdout io.Reader) {
+	defer close(p.done)
+	scanner := bufio.NewScanner(stdout)
+	for slot := range p.pending {
+		n := 0
+		if atomic.LoadInt32(&p.failed) == 0 {
+			if !scanner.Scan() {
+				err := scanner.Err()
+				if err == nil {
+					err = fmt.Errorf("exited")
+				}
+				p.fail(err)
+			} else {
+				var err error
+				n, err = strconv.Atoi(strings.TrimSpace(scanner.Text()))
+				if err != nil || n < 0 || n > len(p.rules) {
+					p.fail(fmt.Errorf("bad answer %q", scanner.Text()))
+					n = 0
+				}
+			}
+		}
+		slot <- n
+	}
+	io.Copy(io.Discard, stdout)
+}
+
+// fail gives up on the plugin; as with other failed lookups the lines stay
+func (p *pluginCheck) fail(err error) {
+	p.once.Do(func() {
+		atomic.StoreInt32(&p.failed, 1)
+		fmt.Fprintf(os.Stderr, "warning: plugin %s failed: %s, its patterns match nothing\n", p.name, err)
+		p.cmd.Process.Kill()
+	})
+}
+
+func (p *pluginCheck) wants(key string) bool {
+	return key != "" && atomic.LoadInt32(&p.failed) == 0
+}
+
+func (p *pluginCheck) check(ctx context.Context, key string) *anot.Rule {
+	slot := make(chan int, 1)
+	p.mu.Lock()
+	select {
+	case p.pending <- slot:
+	case <-ctx.Done():
+		p.mu.Unlock()
+		p.abort()
+		return nil
+	}
+	p.in.WriteString(key)
+	p.in.WriteByte('\n')
+	err := p.in.Flush()
+	p.mu.Unlock()
+	if err != nil {
+		p.fail(err)
+		return nil
+	}
+	select {
+	case n := <-slot:
+		if n > 0 {
+			return p.rules[n-1]
+		}
+	case <-ctx.Done():
+		p.abort()
+	}
+	return nil
+}
+
+// abort kills the plugin when the run stops while keys are still awaiting
+// an answer, which is no failure to warn about
+func (p *pluginCheck) abort() {
+	p.once.Do(func() {
+		atomic.StoreInt32(&p.failed, 1)
+		p.cmd.Process.Kill()
+	})
+}
+
+// stop ends the plugin by closing its input
+func (p *pluginCheck) stop() {
+	if p.cmd == nil {
+		return
+	}
+	// Exiting now is no failure, and no key is in flight any more
+	p.once.Do(func() { atomic.StoreInt32(&p.failed, 1) })
+	p.mu.Lock()
+	p.stdin.Close()
+	close(p.pending)
+	p.mu.Unlock()
+	<-p.done
+	p.cmd.Wait()
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+func TestPluginCheckStopsWithContext(t *testing.T) {
+	// A plugin that reads its keys and never answers
+	p, err := newPlugin("silent=sh -c cat>/dev/null")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.rules = []*anot.Rule{{Pattern: "anything"}}
+	if err := p.start(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	done := make(chan *anot.Rule, 1)
+	go func() { done <- p.check(ctx, "example.com") }()
+	select {
+	case rule := <-done:
+		if rule != nil {
+			t.Errorf("matched %+v without an answer", rule)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("check ignored the context")
+	}
+	if p.wants("example.com") {
+		t.Error("the plugin still takes keys")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		p.stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the plugin wasn't stopped")
+	}
+	if p.cmd.ProcessState == nil || p.cmd.ProcessState.Success() {
+		t.Errorf("the plugin wasn't killed: %v", p.cmd.ProcessState)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBuffer is the largest buffer kept for reuse; bigger ones are
+// left to the garbage collector so one huge file doesn't pin its memory
+const maxPooledBuffer = 64 << 20
+
+// bufferPool recycles the I/O buffers files are read into and per-file
+// output is collected in, so batch runs over many files reuse memory
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBuffer {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
+// linesPool recycles the line slices of read files
+var linesPool sync.Pool
+
+// getLines returns an empty slice with room for at least n lines
+func getLines(n int) []string {
+	if p, ok := linesPool.Get().(*[]string); ok && cap(*p) >= n {
+		return (*p)[:0]
+	}
+	return make([]string, 0, n)
+}
+
+// putLines hands a line slice back once nothing refers to it anymore
+func putLines(lines []string) {
+	if cap(lines) == 0 || cap(lines) > maxPooledBuffer/16 {
+		return
+	}
+	// Drop the references so pooled slices don't keep file contents alive
+	lines = lines[:cap(lines)]
+	for i := range lines {
+		lines[i] = ""
+	}
+	lines = lines[:0]
+	linesPool.Put(&lines)
+}
@@ -0,0 +1,43 @@
+package main
+
+import "strings"
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "access-log",
+		Description: "client address and virtual host of Apache/nginx common and combined logs",
+		Keys:        accessLogKeys,
+	})
+}
+
+// accessLogKeys extracts the client address, and the virtual host when
+// present, from Apache/nginx common or combined log format lines:
+//
+//	10.1.2.3 - frank [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.0" 200 2326
+//	www.example.com:443 10.1.2.3 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1" 200 512
+func accessLogKeys(line string) []string {
+	fields := strings.Fields(line)
+
+	// Everything before the [timestamp] is host, ident and user; a
+	// bracketed IPv6 vhost has no '/' and is not mistaken for it
+	var head []string
+	for _, f := range fields {
+		if strings.HasPrefix(f, "[") && strings.Contains(f, "/") {
+			break
+		}
+		head = append(head, f)
+	}
+	if len(head) == len(fields) || len(head) < 3 {
+		// Not an access log line
+		return nil
+	}
+
+	var keys []string
+	if len(head) >= 4 {
+		// vhost_combined: %v:%p before the client address
+		keys = append(keys, hostFromToken(head[0]), hostFromToken(head[1]))
+	} else {
+		keys = append(keys, hostFromToken(head[0]))
+	}
+	return keys
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// keyTest is one case of a preset's key extraction
+type keyTest struct {
+	name string
+	line string
+	want []string
+}
+
+func testKeys(t *testing.T, keys keyFunc, tests []keyTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keys(tt.line); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keys(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessLogKeys(t *testing.T) {
+	testKeys(t, accessLogKeys, []keyTest{
+		{"common", `10.1.2.3 - frank [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.0" 200 2326`, []string{"10.1.2.3"}},
+		{"combined", `2001:db8::2 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1" 200 512 "-" "curl/8.0"`,
+			[]string{"2001:db8::2"}},
+		{"vhost", `www.example.com:443 10.1.2.3 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1" 200 512`,
+			[]string{"www.example.com", "10.1.2.3"}},
+		{"bracketed vhost", `[2001:db8::1]:443 2001:db8::2 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1" 200 512`,
+			[]string{"2001:db8::1", "2001:db8::2"}},
+		{"no timestamp", "10.1.2.3 - frank GET /", nil},
+		{"short head", "10.1.2.3 [10/Oct/2000:13:55:36 -0700]", nil},
+		{"empty", "", nil},
+	})
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "amass",
+		Description: "discovered names and resolved addresses of amass text and JSON output",
+		Keys:        amassKeys,
+	})
+}
+
+// amassRecord is one line of amass -json output
+type amassRecord struct {
+	Name      string `json:"name"`
+	Addresses []struct {
+		IP string `json:"ip"`
+	} `json:"addresses"`
+	Sources []string `json:"sources"`
+}
+
+// amassKeys extracts the discovered names and resolved addresses of amass
+// output in its JSON, default, -src, -ip and v4 relationship forms:
+//
+//	{"name":"www.example.com","addresses":[{"ip":"93.184.216.34"}],"sources":["DNS"]}
+//	[Crtsh]           www.example.com 93.184.216.34,2606:2800:220:1::
+//	www.example.com (FQDN) --> a_record --> 93.184.216.34 (IPAddress)
+func amassKeys(line string) []string {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "{") {
+		var rec amassRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil || rec.Name == "" {
+			return nil
+		}
+		keys := []string{rec.Name}
+		for _, a := range rec.Addresses {
+			keys = append(keys, a.IP)
+		}
+		return keys
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "[") && strings.HasSuffix(fields[0], "]") {
+		// -src prefixes the data source
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if strings.Contains(line, "-->") {
+		// v4 relationship lines: each node is followed by its (Type)
+		var keys []string
+		for i := 1; i < len(fields); i++ {
+			if fields[i] == "(FQDN)" || fields[i] == "(IPAddress)" {
+				keys = append(keys, fields[i-1])
+			}
+		}
+		return keys
+	}
+
+	keys := []string{fields[0]}
+	if len(fields) > 1 {
+		// -ip appends a comma separated list of addresses
+		for _, addr := range strings.Split(fields[1], ",") {
+			if isIPAddr(addr) {
+				keys = append(keys, addr)
+			}
+		}
+	}
+	return keys
+}
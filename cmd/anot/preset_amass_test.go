@@ -0,0 +1,16 @@
+package main
+
+import "testing"
+
+func TestAmassKeys(t *testing.T) {
+	testKeys(t, amassKeys, []keyTest{
+		{"json", `{"name":"www.example.com","addresses":[{"ip":"93.184.216.34"}],"sources":["DNS"]}`,
+			[]string{"www.example.com", "93.184.216.34"}},
+		{"json without name", `{"addresses":[]}`, nil},
+		{"enum -src -ip", "[Crtsh]           www.example.com 93.184.216.34,2606:2800:220:1::",
+			[]string{"www.example.com", "93.184.216.34", "2606:2800:220:1::"}},
+		{"graph", "www.example.com (FQDN) --> a_record --> 93.184.216.34 (IPAddress)",
+			[]string{"www.example.com", "93.184.216.34"}},
+		{"name only", "www.example.com", []string{"www.example.com"}},
+	})
+}
@@ -0,0 +1,63 @@
+package main
+
+import "strings"
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "dnsmasq",
+		Description: "domains and addresses of address=/domain/ip, server=, local=, ipset= and host-record= directives",
+		Keys:        dnsmasqKeys,
+	})
+}
+
+// dnsmasqDomainDirectives are the dnsmasq options taking /domain/.../ lists
+var dnsmasqDomainDirectives = map[string]bool{
+	"address": true, "server": true, "local": true, "ipset": true, "nftset": true, "rebind-domain-ok": true,
+}
+
+// dnsmasqKeys extracts the domains and the address of dnsmasq directives:
+//
+//	address=/ads.example.com/0.0.0.0
+//	server=/corp.example.com/internal.example.com/10.0.0.53#53
+//	host-record=nas.lan,192.168.1.10
+//
+// Other configuration lines yield no keys and are always kept.
+func dnsmasqKeys(line string) []string {
+	line = strings.TrimSpace(line)
+	name, value, ok := strings.Cut(line, "=")
+	if !ok || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	name = strings.TrimPrefix(strings.TrimSpace(name), "--")
+	value = strings.TrimSpace(value)
+
+	var keys []string
+	switch {
+	case dnsmasqDomainDirectives[name] && strings.HasPrefix(value, "/"):
+		parts := strings.Split(value[1:], "/")
+		// The last part is the address or upstream server, possibly empty
+		for _, domain := range parts[:len(parts)-1] {
+			if domain = strings.TrimPrefix(domain, "."); domain != "" && domain != "#" {
+				keys = append(keys, domain)
+			}
+		}
+		if addr := dnsmasqAddress(parts[len(parts)-1]); addr != "" {
+			keys = append(keys, addr)
+		}
+	case name == "server" || name == "host-record" || name == "cname" || name == "ptr-record":
+		for _, part := range strings.Split(value, ",") {
+			if part = dnsmasqAddress(part); part != "" {
+				keys = append(keys, part)
+			}
+		}
+	}
+	return keys
+}
+
+// dnsmasqAddress strips the #port and @interface suffixes of an upstream
+func dnsmasqAddress(s string) string {
+	if i := strings.IndexAny(s, "#@"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
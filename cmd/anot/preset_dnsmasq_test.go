@@ -0,0 +1,16 @@
+package main
+
+import "testing"
+
+func TestDnsmasqKeys(t *testing.T) {
+	testKeys(t, dnsmasqKeys, []keyTest{
+		{"address", "address=/ads.example.com/0.0.0.0", []string{"ads.example.com", "0.0.0.0"}},
+		{"server for domains", "server=/corp.example.com/internal.example.com/10.0.0.53#53",
+			[]string{"corp.example.com", "internal.example.com", "10.0.0.53"}},
+		{"server with interface", "server=10.0.0.1@eth0", []string{"10.0.0.1"}},
+		{"host-record", "host-record=nas.lan,192.168.1.10", []string{"nas.lan", "192.168.1.10"}},
+		{"leading dot", "local=/.lan/", []string{"lan"}},
+		{"comment", "# address=/x.com/1.2.3.4", nil},
+		{"other directive", "cache-size=1000", nil},
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import "encoding/json"
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "httpx",
+		Description: "input, host, url host and a/aaaa addresses of httpx JSONL",
+		Keys:        httpxKeys,
+	})
+}
+
+// httpxRecord holds the httpx -json fields that identify the probed host
+type httpxRecord struct {
+	Input string   `json:"input"`
+	Host  string   `json:"host"`
+	URL   string   `json:"url"`
+	A     []string `json:"a"`
+	AAAA  []string `json:"aaaa"`
+}
+
+// httpxKeys extracts the input, host, URL host and resolved addresses of
+// httpx JSONL output
+func httpxKeys(line string) []string {
+	var rec httpxRecord
+	if err := json.Unmarshal([]byte(line), &rec); err != nil {
+		return nil
+	}
+
+	var keys []string
+	for _, v := range []string{rec.Input, rec.Host, rec.URL} {
+		if host := hostFromToken(v); host != "" {
+			keys = append(keys, host)
+		}
+	}
+	keys = append(keys, rec.A...)
+	return append(keys, rec.AAAA...)
+}
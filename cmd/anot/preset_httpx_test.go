@@ -0,0 +1,12 @@
+package main
+
+import "testing"
+
+func TestHttpxKeys(t *testing.T) {
+	testKeys(t, httpxKeys, []keyTest{
+		{"all fields", `{"input":"example.com","host":"93.184.216.34","url":"https://www.example.com:443/x","a":["93.184.216.34"],"aaaa":["2606:2800:220:1::"]}`,
+			[]string{"example.com", "93.184.216.34", "www.example.com", "93.184.216.34", "2606:2800:220:1::"}},
+		{"url only", `{"url":"http://10.0.0.1:8080/"}`, []string{"10.0.0.1"}},
+		{"not json", "not json", nil},
+	})
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "masscan",
+		Description: "IP and :PORT of masscan list (-oL) and JSON (-oJ) output",
+		Keys:        masscanKeys,
+		Fixup:       fixJSONArraySeparators,
+		Match:       matchMasscan,
+	})
+}
+
+// masscanRecord is one host of masscan's -oJ output
+type masscanRecord struct {
+	IP    string `json:"ip"`
+	Ports []struct {
+		Port int `json:"port"`
+	} `json:"ports"`
+}
+
+// masscanKeys extracts the IP and ":PORT" keys of masscan list (-oL) and
+// JSON (-oJ) output, so CIDRs match the address and ":80" style patterns
+// match the port:
+//
+//	open tcp 80 10.0.0.1 1390000000
+//	{   "ip": "10.0.0.1",   "timestamp": "1390000000", "ports": [ {"port": 80, "proto": "tcp", "status": "open"} ] },
+func masscanKeys(line string) []string {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "{") {
+		var rec masscanRecord
+		if err := json.Unmarshal([]byte(strings.TrimSuffix(line, ",")), &rec); err != nil || rec.IP == "" {
+			return nil
+		}
+		keys := []string{rec.IP}
+		for _, p := range rec.Ports {
+			keys = append(keys, ":"+strconv.Itoa(p.Port))
+		}
+		return keys
+	}
+
+	// STATE PROTO PORT IP TIMESTAMP [...]
+	fields := strings.Fields(line)
+	if len(fields) < 4 || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	return []string{fields[3], ":" + fields[2]}
+}
+
+// matchMasscan removes an entry when its IP matches and, once there are
+// :PORT patterns, its port matches one of them as well
+func matchMasscan(m *anot.Matcher, keys []string) *anot.Rule {
+	var addrs, ports []string
+	for _, key := range keys {
+		if strings.HasPrefix(key, ":") {
+			ports = append(ports, key)
+		} else {
+			addrs = append(addrs, key)
+		}
+	}
+	rule := m.MatchKeys(addrs)
+	if rule == nil || !m.HasPortPatterns() || m.MatchKeys(ports) != nil {
+		return rule
+	}
+	return nil
+}
+
+// fixJSONArraySeparators repairs the commas of a one-element-per-line JSON
+// array after elements were removed, keeping the file's separator style:
+// either a trailing comma on each element, or "," on a line of its own
+// between elements as newer masscan versions write it
+func fixJSONArraySeparators(lines []string) []string {
+	first := ""
+	for _, line := range lines {
+		if first = strings.TrimSpace(line); first != "" {
+			break
+		}
+	}
+	if first != "[" {
+		return lines
+	}
+
+	separateLines := false
+	var elements []int
+	for i, line := range lines {
+		switch t := strings.TrimSpace(line); {
+		case t == ",":
+			separateLines = true
+		case strings.HasPrefix(t, "{"):
+			elements = append(elements, i)
+		}
+	}
+
+	out := make([]string, 0, len(lines))
+	next := 0
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "," {
+			continue
+		}
+		if next >= len(elements) || elements[next] != i {
+			out = append(out, line)
+			continue
+		}
+
+		last := next == len(elements)-1
+		elem := strings.TrimRight(line, " \t")
+		elem = strings.TrimSuffix(elem, ",")
+		switch {
+		case last:
+			out = append(out, elem)
+		case separateLines:
+			out = append(out, elem, ",")
+		default:
+			out = append(out, elem+",")
+		}
+		next++
+	}
+	return out
+}
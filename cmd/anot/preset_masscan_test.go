@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestMatchMasscan(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns string
+		line     string
+		removed  bool
+	}{
+		{"address and port", "10.0.0.0/8\n:80\n", "open tcp 80 10.0.0.1 1390000000", true},
+		{"address only", "10.0.0.0/8\n:80\n", "open tcp 443 10.0.0.1 1390000000", false},
+		{"port only", "10.0.0.0/8\n:80\n", "open tcp 80 192.168.0.1 1390000000", false},
+		{"no port patterns", "10.0.0.0/8\n", "open tcp 443 10.0.0.1 1390000000", true},
+		{"port patterns only", ":80\n", "open tcp 80 192.168.0.1 1390000000", false},
+		{"json, one port of several", "10.0.0.0/8\n:80\n",
+			`{   "ip": "10.0.0.1",   "timestamp": "1390000000", "ports": [ {"port": 443}, {"port": 80} ] },`, true},
+		{"json, port only", "10.0.0.0/8\n:80\n",
+			`{   "ip": "192.168.0.1",   "timestamp": "1390000000", "ports": [ {"port": 80} ] },`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := anot.ReadRules(strings.NewReader(tt.patterns), false, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rule := matchMasscan(anot.NewMatcher(rules), masscanKeys(tt.line))
+			if (rule != nil) != tt.removed {
+				t.Errorf("removed = %v, want %v", rule != nil, tt.removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"net"
+	"strings"
+)
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "naabu",
+		Description: "host (wildcards, CIDRs) and :PORT of naabu host:port lines",
+		Keys:        naabuKeys,
+	})
+}
+
+// naabuKeys extracts the host and ":PORT" keys of naabu host:port lines,
+// including bracketed IPv6 addresses such as [2001:db8::1]:443
+func naabuKeys(line string) []string {
+	line = strings.TrimSpace(line)
+	host, port, err := net.SplitHostPort(line)
+	if err != nil {
+		return nil
+	}
+	return []string{host, ":" + port}
+}
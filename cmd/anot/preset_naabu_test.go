@@ -0,0 +1,12 @@
+package main
+
+import "testing"
+
+func TestNaabuKeys(t *testing.T) {
+	testKeys(t, naabuKeys, []keyTest{
+		{"name", "example.com:443", []string{"example.com", ":443"}},
+		{"ipv4", "10.0.0.1:22", []string{"10.0.0.1", ":22"}},
+		{"ipv6", "[2001:db8::1]:443", []string{"2001:db8::1", ":443"}},
+		{"no port", "example.com", nil},
+	})
+}
@@ -0,0 +1,41 @@
+package main
+
+import "strings"
+
+func init() {
+	for _, name := range []string{"dnsx", "massdns"} {
+		registerPreset(&Preset{
+			Name:        name,
+			Description: "queried name and answers of 'name [type] answer' resolver output",
+			Keys:        resolverKeys,
+		})
+	}
+}
+
+// resolverKeys extracts the queried name and the answers of resolver output
+// in the "name [type] answer" shape written by massdns and dnsx:
+//
+//	www.example.com. A 93.184.216.34
+//	www.example.com [A] [93.184.216.34]
+//	www.example.com [CNAME] [edge.cdn.example.net]
+//	www.example.com [93.184.216.34]
+func resolverKeys(line string) []string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || strings.HasPrefix(fields[0], ";") {
+		return nil
+	}
+
+	var keys []string
+	for i, f := range fields {
+		f = strings.TrimSuffix(strings.Trim(f, "[]"), ".")
+		if f == "" {
+			continue
+		}
+		// The record type sits between the name and the answer
+		if i > 0 && zoneRecordTypes[strings.ToUpper(f)] && !strings.Contains(f, ".") {
+			continue
+		}
+		keys = append(keys, f)
+	}
+	return keys
+}
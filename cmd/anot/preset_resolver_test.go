@@ -0,0 +1,15 @@
+package main
+
+import "testing"
+
+func TestResolverKeys(t *testing.T) {
+	testKeys(t, resolverKeys, []keyTest{
+		{"massdns simple", "www.example.com. A 93.184.216.34", []string{"www.example.com", "93.184.216.34"}},
+		{"dnsx type", "www.example.com [A] [93.184.216.34]", []string{"www.example.com", "93.184.216.34"}},
+		{"dnsx cname", "www.example.com [CNAME] [edge.cdn.example.net]", []string{"www.example.com", "edge.cdn.example.net"}},
+		{"dnsx no type", "www.example.com [93.184.216.34]", []string{"www.example.com", "93.184.216.34"}},
+		{"name like a type", "a [A] [10.0.0.1]", []string{"a", "10.0.0.1"}},
+		{"comment", "; comment", nil},
+		{"empty", "", nil},
+	})
+}
@@ -0,0 +1,57 @@
+package main
+
+import "strings"
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "syslog",
+		Description: "header hostname and IPs/domains in the message of RFC3164/5424 syslog lines",
+		Keys:        syslogKeys,
+	})
+}
+
+// syslogKeys extracts the header hostname plus any IP addresses and domain
+// names mentioned in the message of RFC3164 or RFC5424 syslog lines:
+//
+//	<34>Oct 11 22:14:15 mymachine su: 'su root' failed for lonvick on /dev/pts/8
+//	<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 - message
+//	2003-10-11T22:14:15+00:00 mymachine sshd[42]: Failed password from 10.1.2.3 port 22
+func syslogKeys(line string) []string {
+	rest := line
+	if strings.HasPrefix(rest, "<") {
+		end := strings.Index(rest, ">")
+		if end < 0 {
+			return nil
+		}
+		rest = rest[end+1:]
+	}
+
+	// Find the hostname and the message that follows the header
+	fields := strings.Fields(rest)
+	var host string
+	var msg []string
+	switch {
+	case len(fields) >= 7 && len(fields[0]) <= 2 && isDigit(fields[0][0]):
+		// RFC5424: VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID ...
+		host, msg = fields[2], fields[6:]
+	case len(fields) >= 3 && strings.Contains(fields[0], "T") && strings.Contains(fields[0], "-"):
+		// RFC3339 timestamped RFC3164, as written by rsyslog and journald
+		host, msg = fields[1], fields[2:]
+	case len(fields) >= 5 && len(fields[0]) == 3 && strings.Count(fields[2], ":") == 2:
+		// RFC3164: Mmm dd hh:mm:ss HOSTNAME TAG: MSG
+		host, msg = fields[3], fields[4:]
+	default:
+		return nil
+	}
+
+	var keys []string
+	if host != "-" {
+		keys = append(keys, host)
+	}
+	for _, tok := range lineTokens(strings.Join(msg, " ")) {
+		if h := hostFromToken(tok); isIPAddr(h) || looksLikeDomain(h) {
+			keys = append(keys, h)
+		}
+	}
+	return keys
+}
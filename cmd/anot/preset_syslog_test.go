@@ -0,0 +1,16 @@
+package main
+
+import "testing"
+
+func TestSyslogKeys(t *testing.T) {
+	testKeys(t, syslogKeys, []keyTest{
+		{"rfc3164", "<34>Oct 11 22:14:15 mymachine su: 'su root' failed for lonvick on /dev/pts/8",
+			[]string{"mymachine"}},
+		{"rfc5424", "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 - connect from 10.9.8.7 via relay.example.org",
+			[]string{"mymachine.example.com", "10.9.8.7", "relay.example.org"}},
+		{"no priority", "2003-10-11T22:14:15+00:00 mymachine sshd[42]: Failed password from 10.1.2.3 port 22",
+			[]string{"mymachine", "10.1.2.3"}},
+		{"nil hostname", "<165>1 2003-10-11T22:14:15.003Z - evntslog - ID47 - hi", nil},
+		{"not syslog", "garbage", nil},
+	})
+}
@@ -0,0 +1,58 @@
+package main
+
+import "strings"
+
+func init() {
+	registerPreset(&Preset{
+		Name:        "unbound",
+		Description: "names and addresses of local-zone:, local-data: and local-data-ptr: statements",
+		Keys:        unboundKeys,
+	})
+}
+
+// unboundKeys extracts the names and addresses of Unbound local-zone,
+// local-data and local-data-ptr statements:
+//
+//	local-zone: "ads.example.com." always_nxdomain
+//	local-data: "nas.lan. 3600 IN A 192.168.1.10"
+//	local-data-ptr: "192.168.1.10 nas.lan"
+//
+// Other configuration lines yield no keys and are always kept.
+func unboundKeys(line string) []string {
+	line = strings.TrimSpace(line)
+	name, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return nil
+	}
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, `"`) {
+		if end := strings.Index(value[1:], `"`); end >= 0 {
+			value = value[1 : end+1]
+		}
+	}
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	switch strings.TrimSpace(name) {
+	case "local-zone":
+		return []string{strings.TrimSuffix(fields[0], ".")}
+	case "local-data":
+		keys := []string{strings.TrimSuffix(fields[0], ".")}
+		// Also match the address of A and AAAA records
+		for _, f := range fields[1:] {
+			if isIPAddr(f) {
+				keys = append(keys, f)
+			}
+		}
+		return keys
+	case "local-data-ptr":
+		keys := []string{fields[0]}
+		if len(fields) > 1 {
+			keys = append(keys, strings.TrimSuffix(fields[1], "."))
+		}
+		return keys
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package main
+
+import "testing"
+
+func TestUnboundKeys(t *testing.T) {
+	testKeys(t, unboundKeys, []keyTest{
+		{"local-zone", `local-zone: "ads.example.com." always_nxdomain`, []string{"ads.example.com"}},
+		{"local-data", `local-data: "nas.lan. 3600 IN A 192.168.1.10"`, []string{"nas.lan", "192.168.1.10"}},
+		{"local-data-ptr", `local-data-ptr: "192.168.1.10 nas.lan"`, []string{"192.168.1.10", "nas.lan"}},
+		{"clause", "server:", nil},
+		{"other statement", "verbosity: 1", nil},
+	})
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// Preset describes a known tool or log line format: which values of a
+// line are matched against the patterns, and how to repair the file's
+// structure after lines were removed when lines are not independent
+type Preset struct {
+	Name        string
+	Description string
+	Keys        keyFunc
+	Fixup       func(lines []string) []string
+	// Match, when set, decides how the keys of a line match together;
+	// without it any key matching is enough
+	Match func(m *anot.Matcher, keys []string) *anot.Rule
+}
+
+// presets holds every registered preset by name. Each format lives in its
+// own preset_*.go file and registers itself from init.
+var presets = map[string]*Preset{}
+
+// registerPreset adds a preset to the registry
+func registerPreset(p *Preset) {
+	if _, ok := presets[p.Name]; ok {
+		panic("anot: preset registered twice: " + p.Name)
+	}
+	presets[p.Name] = p
+}
+
+// lookupPreset finds a preset by name
+func lookupPreset(name string) (*Preset, error) {
+	if p, ok := presets[name]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("unknown preset %q (run 'anot presets' for the list)", name)
+}
+
+// presetNames returns the registered preset names in order
+func presetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// runPresets implements "anot presets", listing the presets and what they match on
+func runPresets() {
+	width := 0
+	for _, name := range presetNames() {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	for _, name := range presetNames() {
+		fmt.Printf("%-*s  %s\n", width, name, presets[name].Description)
+	}
+}
+
+// presetUsage lists the preset names for flag help
+func presetUsage() string {
+	return strings.Join(presetNames(), ", ")
+}
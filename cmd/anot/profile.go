@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	pprofile "runtime/pprof"
+	"runtime/trace"
+)
+
+// profiles holds the profile files requested on the command line
+type profiles struct {
+	cpu, mem, trace string
+}
+
+// start begins CPU profiling and execution tracing as requested. The
+// returned function finishes them and writes the heap profile; it must run
+// before the program exits.
+func (p profiles) start() (func() error, error) {
+	var cpuFile, traceFile *os.File
+	stop := func() error {
+		if cpuFile != nil {
+			pprofile.StopCPUProfile()
+			cpuFile.Close()
+		}
+		if traceFile != nil {
+			trace.Stop()
+			traceFile.Close()
+		}
+		if p.mem == "" {
+			return nil
+		}
+		f, err := os.Create(p.mem)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		// Up-to-date statistics on what is still live
+		runtime.GC()
+		return pprofile.WriteHeapProfile(f)
+	}
+
+	if p.cpu != "" {
+		f, err := os.Create(p.cpu)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprofile.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		cpuFile = f
+	}
+	if p.trace != "" {
+		f, err := os.Create(p.trace)
+		if err != nil {
+			stop()
+			return nil, err
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			stop()
+			return nil, err
+		}
+		traceFile = f
+	}
+	return stop, nil
+}
+
+// servePprof serves the net/http/pprof endpoints under /debug/pprof/ on
+// addr, for profiling a long running daemon
+func servePprof(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// progressDelay is how long a run stays silent before progress is shown,
+// so quick runs never draw anything; progressInterval is the redraw rate
+const (
+	progressDelay    = time.Second
+	progressInterval = 200 * time.Millisecond
+)
+
+// progress draws a status line on the terminal while a long run works
+// through a file: what it is doing, how far it got and when it should be
+// done. A nil *progress draws nothing, so callers need not check.
+type progress struct {
+	w    io.Writer
+	name string
+
+	// done counts the units of the current phase, updated atomically
+	done int64
+
+	mu         sync.Mutex
+	phase      string
+	unit       string
+	total      int64
+	phaseStart time.Time
+	drawn      bool
+
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// isTerminal reports whether f is a terminal rather than a file or pipe
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// newProgress starts drawing the progress of filtering the named file on
+// w, or returns nil when w is not a terminal
+func newProgress(w io.Writer, name string) *progress {
+	f, ok := w.(*os.File)
+	if !ok || !isTerminal(f) {
+		return nil
+	}
+	p := &progress{w: w, name: name, stop: make(chan struct{}), stopped: make(chan struct{})}
+	go p.draw()
+	return p
+}
+
+// start begins a new phase of total units, such as lines or bytes
+func (p *progress) start(phase, unit string, total int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.phase, p.unit, p.total, p.phaseStart = phase, unit, total, time.Now()
+	atomic.StoreInt64(&p.done, 0)
+	p.mu.Unlock()
+}
+
+// add counts n more units of the current phase as done
+func (p *progress) add(n int) {
+	if p != nil {
+		atomic.AddInt64(&p.done, int64(n))
+	}
+}
+
+// finish stops drawing and clears the status line
+func (p *progress) finish() {
+	if p == nil {
+		return
+	}
+	close(p.stop)
+	<-p.stopped
+	if p.drawn {
+		fmt.Fprint(p.w, "\r\x1b[K")
+	}
+}
+
+func (p *progress) draw() {
+	defer close(p.stopped)
+	select {
+	case <-time.After(progressDelay):
+	case <-p.stop:
+		return
+	}
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		p.mu.Lock()
+		line := p.status()
+		p.mu.Unlock()
+		if line != "" {
+			fmt.Fprintf(p.w, "\r%s\x1b[K", line)
+			p.drawn = true
+		}
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// status formats the current phase, e.g.
+// "scope.txt: matching 1,200,000/3,000,000 lines (40%), ETA 12s"
+func (p *progress) status() string {
+	if p.phase == "" {
+		return ""
+	}
+	done := atomic.LoadInt64(&p.done)
+	if p.total <= 0 {
+		// Phases of unknown size only say what is going on
+		return fmt.Sprintf("%s: %s...", p.name, p.phase)
+	}
+	line := fmt.Sprintf("%s: %s %s/%s %s", p.name, p.phase, p.amount(done), p.amount(p.total), p.unit)
+	if done > p.total {
+		done = p.total
+	}
+	line += fmt.Sprintf(" (%d%%)", done*100/p.total)
+	if elapsed := time.Since(p.phaseStart); done > 0 && elapsed > progressDelay {
+		left := time.Duration(float64(elapsed) * float64(p.total-done) / float64(done))
+		line += ", ETA " + left.Round(time.Second).String()
+	}
+	return line
+}
+
+// amount formats a count of the phase's unit, bytes in megabytes
+func (p *progress) amount(n int64) string {
+	if p.unit == "MB" {
+		return strconv.FormatFloat(float64(n)/(1<<20), 'f', 1, 64)
+	}
+	return groupThousands(n)
+}
+
+// groupThousands formats n with comma separated groups of three digits
+func groupThousands(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	for i := len(s) - 3; i > 0 && s[i-1] != '-'; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
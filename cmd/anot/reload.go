@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// patternSource is where a long running mode, named by who in messages,
+// loads its patterns from, so they can be loaded again when they change
+type patternSource struct {
+	who          string
+	compiledFile string
+	patternFiles []string
+	asnDBFile    string
+	extended     bool
+	trim         bool
+	// hooks are the expressions run around every match, if any
+	hooks *anot.Hooks
+
+	// asnDB is the ASN database opened by the last load
+	asnDB anot.ASNDatabase
+	// metrics count loads and reloads, if set
+	metrics *metrics
+
+	// mu serializes loads, which reloads may start from several places
+	mu sync.Mutex
+	// stamps are the sizes and modification times of the files as they
+	// were just before the last load
+	stamps map[string]string
+}
+
+// files returns every file the patterns are loaded from
+func (s *patternSource) files() []string {
+	files := append([]string(nil), s.patternFiles...)
+	for _, fn := range []string{s.compiledFile, s.asnDBFile} {
+		if fn != "" {
+			files = append(files, fn)
+		}
+	}
+	return files
+}
+
+// stamp returns the size and modification time of a file, or "" when it
+// can't be read
+func stamp(fn string) string {
+	info, err := os.Stat(fn)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d %d", info.Size(), info.ModTime().UnixNano())
+}
+
+// load loads and compiles the patterns, returning them and their matcher
+func (s *patternSource) load() ([]*anot.Rule, *anot.Matcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reload := s.stamps != nil
+	rules, matcher, err := s.loadFiles()
+	s.metrics.loaded(len(rules), err, reload)
+	return rules, matcher, err
+}
+
+// stampFiles returns the stamps of every file the patterns are loaded from
+func (s *patternSource) stampFiles() map[string]string {
+	stamps := make(map[string]string)
+	for _, fn := range s.files() {
+		stamps[fn] = stamp(fn)
+	}
+	return stamps
+}
+
+func (s *patternSource) loadFiles() ([]*anot.Rule, *anot.Matcher, error) {
+	// Stamped first, so a change while loading means another reload
+	stamps := s.stampFiles()
+	rules, matcher, err := loadResidentMatcher(s.who, s.compiledFile, s.patternFiles, s.extended, s.trim)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.hooks != nil && s.hooks.Clean != nil {
+		// Cleaned patterns need matching as they are now
+		cleanRules(rules, s.hooks.Clean)
+		matched, _ := splitWhoisPatterns(rules)
+		matcher = anot.NewMatcher(matched)
+	}
+	var db anot.ASNDatabase
+	if s.asnDBFile != "" {
+		if db, err = openASNDatabase(s.asnDBFile); err != nil {
+			return nil, nil, fmt.Errorf("failed to read ASN database: %s", err)
+		}
+	}
+	if err := s.setASNDatabase(matcher, db); err != nil {
+		return nil, nil, err
+	}
+	if s.hooks != nil {
+		matcher.SetHooks(s.hooks)
+	}
+	s.stamps, s.asnDB = stamps, db
+	return rules, matcher, nil
+}
+
+// compile builds the matcher of other rules than the loaded ones, with the
+// ASN database of the last load
+func (s *patternSource) compile(rules []*anot.Rule) (*anot.Matcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, whois := splitWhoisPatterns(rules); len(whois) > 0 {
+		return nil, fmt.Errorf("%s does not support whois-org: patterns", s.who)
+	}
+	if s.hooks != nil {
+		cleanRules(rules, s.hooks.Clean)
+	}
+	matcher := anot.NewMatcher(rules)
+	if err := s.setASNDatabase(matcher, s.asnDB); err != nil {
+		return nil, err
+	}
+	if s.hooks != nil {
+		matcher.SetHooks(s.hooks)
+	}
+	s.metrics.loaded(len(rules), nil, false)
+	return matcher, nil
+}
+
+func (s *patternSource) setASNDatabase(matcher *anot.Matcher, db anot.ASNDatabase) error {
+	if db != nil {
+		matcher.SetASNDatabase(db)
+	} else if matcher.HasASNPatterns() {
+		return fmt.Errorf("asn: patterns need an -asn-db database")
+	}
+	return nil
+}
+
+// changed reports whether any file changed since the last load
+func (s *patternSource) changed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for fn, st := range s.stamps {
+		if stamp(fn) != st {
+			return true
+		}
+	}
+	return false
+}
+
+// reloadOn loads the patterns again on SIGHUP and, unless interval is zero,
+// whenever a file they come from changes, checking every interval. apply
+// receives every newly loaded set of rules and its matcher; a failed reload
+// is reported and the patterns in use stay as they are.
+func (s *patternSource) reloadOn(interval time.Duration, apply func([]*anot.Rule, *anot.Matcher)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	var poll <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		poll = ticker.C
+	}
+	go func() {
+		for {
+			select {
+			case <-hup:
+			case <-poll:
+				if !s.changed() {
+					continue
+				}
+			}
+			rules, matcher, err := s.load()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error: reloading patterns: %s\n", err)
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "reloaded %d patterns\n", len(rules))
+			apply(rules, matcher)
+		}
+	}()
+}
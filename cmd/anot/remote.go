@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// serverTimeout bounds fetching the pattern set from an anot server
+const serverTimeout = time.Minute
+
+var serverClient = &http.Client{Timeout: serverTimeout}
+
+// serverCachePath returns where the pattern set of an anot server is kept
+// between runs
+func serverCachePath(server string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(server))
+	name := hex.EncodeToString(sum[:8]) + ".v" + strconv.Itoa(anot.CompiledVersion)
+	return filepath.Join(dir, "anot", "server", name), nil
+}
+
+// serverPatterns returns the compiled pattern set of an "anot serve" at
+// server. The copy cached by the last run is revalidated with its hash, so
+// an unchanged set is not downloaded again, and used as is, with a warning,
+// when the server can't be reached.
+func serverPatterns(ctx context.Context, server, token string) (*anot.CompiledPatterns, error) {
+	path, err := serverCachePath(server)
+	if err != nil {
+		return nil, err
+	}
+	cached, _ := readCompiled(path)
+
+	fresh, err := fetchCompiled(ctx, server, token, cached)
+	if err != nil {
+		if cached == nil {
+			return nil, fmt.Errorf("%s: %s", server, err)
+		}
+		fmt.Fprintf(os.Stderr, "warning: %s: %s, using the patterns cached by an earlier run\n", server, err)
+		return cached, nil
+	}
+	if fresh == cached {
+		return cached, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+		err = writeCompiled(path, fresh)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to cache the patterns of %s: %s\n", server, err)
+	}
+	return fresh, nil
+}
+
+// fetchCompiled downloads the compiled pattern set of an anot server, or
+// returns cached when it is still current
+func fetchCompiled(ctx context.Context, server, token string, cached *anot.CompiledPatterns) (*anot.CompiledPatterns, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+"/compiled", nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if cached != nil {
+		req.Header.Set("If-None-Match", strconv.Quote(cached.Hash))
+	}
+	resp, err := serverClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		if cached == nil {
+			return nil, fmt.Errorf("unexpected %s", resp.Status)
+		}
+		return cached, nil
+	case http.StatusOK:
+	default:
+		return nil, fmt.Errorf("server answered %s", resp.Status)
+	}
+
+	// Decoded before it replaces the cached copy, which a bad download
+	// must not destroy
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	c := &anot.CompiledPatterns{}
+	if err := gob.NewDecoder(bytes.NewReader(body)).Decode(c); err != nil {
+		return nil, fmt.Errorf("not a compiled pattern set: %s", err)
+	}
+	if c.Version != anot.CompiledVersion {
+		return nil, fmt.Errorf("compiled pattern set version %d, want %d", c.Version, anot.CompiledVersion)
+	}
+	return c, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// multiLabelSuffixes lists common public suffixes made of two labels. It is
+// a small built-in subset of the Public Suffix List, good enough to group
+// recon output by organization without shipping the full list.
+var multiLabelSuffixes = map[string]bool{
+	"ac.uk": true, "co.uk": true, "gov.uk": true, "ltd.uk": true, "me.uk": true, "net.uk": true, "org.uk": true, "plc.uk": true,
+	"com.au": true, "edu.au": true, "gov.au": true, "net.au": true, "org.au": true,
+	"co.nz": true, "govt.nz": true, "net.nz": true, "org.nz": true,
+	"ac.jp": true, "co.jp": true, "go.jp": true, "ne.jp": true, "or.jp": true,
+	"co.kr": true, "or.kr": true, "com.cn": true, "net.cn": true, "org.cn": true, "gov.cn": true,
+	"com.hk": true, "com.sg": true, "com.tw": true, "com.my": true, "co.id": true, "co.in": true, "net.in": true, "org.in": true,
+	"co.th": true, "com.vn": true, "com.ph": true, "com.pk": true,
+	"com.br": true, "net.br": true, "org.br": true, "gov.br": true, "com.ar": true, "com.mx": true, "com.co": true, "com.pe": true, "com.uy": true,
+	"co.za": true, "co.il": true, "com.tr": true, "com.eg": true, "com.sa": true, "com.ua": true, "com.pl": true,
+	"github.io": true, "gitlab.io": true, "herokuapp.com": true, "azurewebsites.net": true, "cloudfront.net": true,
+	"appspot.com": true, "blogspot.com": true, "netlify.app": true, "vercel.app": true, "pages.dev": true, "workers.dev": true,
+}
+
+// apexDomain returns the registrable domain of a host name, or "" if the
+// value does not look like a domain
+func apexDomain(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimPrefix(host, "*.")), ".")
+	if host == "" || isIPAddr(host) || strings.ContainsAny(host, " \t/:@") {
+		return ""
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return ""
+	}
+	for _, label := range labels {
+		if label == "" {
+			return ""
+		}
+	}
+
+	n := 2
+	if len(labels) > 2 && multiLabelSuffixes[strings.Join(labels[len(labels)-2:], ".")] {
+		n = 3
+	}
+	return strings.Join(labels[len(labels)-n:], ".")
+}
+
+// writeApexReport prints the surviving domains grouped by apex, most common first
+func writeApexReport(w io.Writer, lines []string) {
+	counts := make(map[string]int)
+	for _, line := range lines {
+		if apex := apexDomain(strings.TrimSpace(line)); apex != "" {
+			counts[apex]++
+		}
+	}
+
+	apexes := make([]string, 0, len(counts))
+	for apex := range counts {
+		apexes = append(apexes, apex)
+	}
+	sort.Slice(apexes, func(i, j int) bool {
+		if counts[apexes[i]] != counts[apexes[j]] {
+			return counts[apexes[i]] > counts[apexes[j]]
+		}
+		return apexes[i] < apexes[j]
+	})
+
+	for _, apex := range apexes {
+		fmt.Fprintf(w, "%7d %s\n", counts[apex], apex)
+	}
+}
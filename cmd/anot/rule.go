@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// loadRules reads the rules from the given pattern files, or from stdin
+// when there are none
+func loadRules(patternFiles []string, extended, trim bool) ([]*anot.Rule, error) {
+	if len(patternFiles) == 0 {
+		promptPatterns()
+		return anot.ReadRules(os.Stdin, extended, trim)
+	}
+
+	var rules []*anot.Rule
+	for _, fn := range patternFiles {
+		f, err := os.Open(fn)
+		if err != nil {
+			return nil, err
+		}
+		fileRules, err := anot.ReadRules(f, extended, trim)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", fn, err)
+		}
+		rules = append(rules, fileRules...)
+	}
+	return rules, nil
+}
+
+// promptPatterns says how to end the patterns when they are read from a
+// terminal, where anot would otherwise seem to hang
+func promptPatterns() {
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return
+	}
+	eof := "Ctrl-D"
+	if runtime.GOOS == "windows" {
+		eof = "Ctrl-Z and Enter"
+	}
+	fmt.Fprintf(os.Stderr, "no -p given, reading the patterns from the terminal, one per line; end them with %s\n", eof)
+}
+
+// stringList is a flag.Value collecting every occurrence of a repeatable flag
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
@@ -0,0 +1,8 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package main
+
+// peakRSS is not available on this platform
+func peakRSS() (int64, bool) {
+	return 0, false
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/subtle"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/hasshido/anot"
+)
+
+// filterHoldBack is how much of a /filter answer is held before any of it
+// is sent, so that a body failing early still gets an error status
+const filterHoldBack = 64 << 10
+
+// patternServer is "anot serve": the pattern set and its matcher, which the
+// API reads and replaces
+type patternServer struct {
+	token   string
+	metrics *metrics
+	// maxBody caps the request bodies of /filter and /patterns
+	maxBody int64
+
+	// mu serializes changes of the pattern set and access to src
+	mu    sync.Mutex
+	src   *patternSource
+	rules []*anot.Rule
+	live  anot.LiveMatcher
+	// compiled is the encoded pattern set /compiled serves, built on demand,
+	// and compiledHash its hash
+	compiled     []byte
+	compiledHash string
+}
+
+// checkResult is what /check answers for a value
+type checkResult struct {
+	Value   string `json:"value"`
+	Matched bool   `json:"matched"`
+	Pattern string `json:"pattern,omitempty"`
+	Action  string `json:"action,omitempty"`
+	Kept    bool   `json:"kept"`
+	Line    string `json:"line,omitempty"`
+}
+
+// runServe implements "anot serve": the patterns are loaded once and an
+// HTTP API checks values, filters posted lines and manages the pattern set
+// against them, for services that can't run anot themselves
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("anot serve", flag.ExitOnError)
+	var listen string
+	var token string
+	var maxBody string
+	var compiledFile string
+	var patternFiles stringList
+	var asnDBFile string
+	var trim bool
+	var extended bool
+	fs.StringVar(&listen, "listen", "localhost:8080", "address to serve the API on")
+	fs.StringVar(&token, "token", "", "require \"Authorization: Bearer TOKEN\" on every request (default $ANOT_TOKEN)")
+	fs.StringVar(&maxBody, "max-body", "64M", "largest request body accepted by /filter and /patterns, such as 64M")
+	fs.Var(&patternFiles, "p", "read patterns from this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	fs.StringVar(&asnDBFile, "asn-db", "", "ASN database for asn:NUMBER patterns")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files and posted patterns")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot serve [options] [-p patterns.txt...]\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	// Pattern files given as arguments are the same as -p
+	patternFiles = append(patternFiles, fs.Args()...)
+	if token == "" {
+		token = os.Getenv("ANOT_TOKEN")
+	}
+	bodyLimit, err := parseSize(maxBody)
+	if err != nil || bodyLimit == 0 {
+		return usageError("-max-body: invalid size %q", maxBody)
+	}
+
+	stats := newMetrics()
+	s := &patternServer{
+		token:   token,
+		metrics: stats,
+		maxBody: bodyLimit,
+		src: &patternSource{who: "anot serve", compiledFile: compiledFile, patternFiles: patternFiles,
+			asnDBFile: asnDBFile, extended: extended, trim: trim, metrics: stats},
+	}
+	if err := s.reload(); err != nil {
+		return patternsError(err)
+	}
+
+	// SIGHUP loads the pattern files again, dropping changes made over the API
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := s.reload(); err != nil {
+				fmt.Fprintf(os.Stderr, "error: reloading patterns: %s\n", err)
+			}
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/check", stats.timed("check", s.handleCheck))
+	mux.HandleFunc("/filter", stats.timed("filter", s.handleFilter))
+	mux.HandleFunc("/patterns", stats.timed("patterns", s.handlePatterns))
+	mux.HandleFunc("/reload", stats.timed("reload", s.handleReload))
+	mux.HandleFunc("/compiled", stats.timed("compiled", s.handleCompiled))
+	mux.Handle("/metrics", stats)
+	fmt.Fprintf(os.Stderr, "serving %d patterns on http://%s\n", len(s.rules), listen)
+	return generalError(http.ListenAndServe(listen, s.authorize(mux)))
+}
+
+// reload loads the patterns from their files again
+func (s *patternServer) reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rules, matcher, err := s.src.load()
+	if err != nil {
+		return err
+	}
+	s.rules, s.compiled = rules, nil
+	s.live.Store(matcher)
+	fmt.Fprintf(os.Stderr, "loaded %d patterns\n", len(rules))
+	return nil
+}
+
+// replace makes rules the pattern set, keeping the old one on failure
+func (s *patternServer) replace(rules []*anot.Rule) error {
+	matcher, err := s.src.compile(rules)
+	if err != nil {
+		return err
+	}
+	s.rules, s.compiled = rules, nil
+	s.live.Store(matcher)
+	return nil
+}
+
+func (s *patternServer) authorize(next http.Handler) http.Handler {
+	if s.token == "" {
+		return next
+	}
+	want := []byte("Bearer " + s.token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleCheck answers GET /check?value=V (repeatable) with what the rules
+// do to each value
+func (s *patternServer) handleCheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "use GET", http.StatusMethodNotAllowed)
+		return
+	}
+	values := r.URL.Query()["value"]
+	if len(values) == 0 {
+		http.Error(w, "missing value parameter", http.StatusBadRequest)
+		return
+	}
+	matcher := s.live.Load()
+	results := make([]checkResult, 0, len(values))
+	for _, value := range values {
+		res := checkResult{Value: value, Kept: true}
+		key := value
+		if s.src.trim {
+			key = strings.TrimSpace(value)
+		}
+		rule := matcher.Match(key)
+		if rule != nil {
+			res.Matched, res.Pattern, res.Action = true, rule.Pattern, rule.Action.String()
+			var line string
+			line, res.Kept = rule.Apply(value)
+			if res.Kept && line != value {
+				res.Line = line
+			}
+		}
+		s.metrics.line(rule, res.Kept)
+		results = append(results, res)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(results)
+}
+
+// handleFilter answers POST /filter with the surviving lines of the body,
+// streamed as they are filtered. The first filterHoldBack bytes are held,
+// so a small body that can't be filtered, with a line too long or a failed
+// read, gets an error status; past them a failure breaks off the answer,
+// which the client sees as a truncated response rather than a complete one.
+func (s *patternServer) handleFilter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, s.maxBody)
+	// The whole batch is filtered against the same patterns
+	matcher := s.live.Load()
+	out := &heldWriter{w: w, limit: filterHoldBack}
+	err := anot.Filter(out, r.Body, matcher, anot.FilterOptions{Trim: s.src.trim, Observe: s.metrics.line})
+	if err == nil && !out.sent {
+		err = out.send()
+	}
+	if err == nil {
+		return
+	}
+	if out.sent {
+		panic(http.ErrAbortHandler)
+	}
+	status := http.StatusBadRequest
+	if tooLarge(err) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	http.Error(w, err.Error(), status)
+}
+
+// heldWriter holds what is written to an answer until there are limit
+// bytes of it, then sends them and streams the rest
+type heldWriter struct {
+	w     http.ResponseWriter
+	limit int
+	held  []byte
+	sent  bool
+}
+
+func (h *heldWriter) Write(p []byte) (int, error) {
+	if h.sent {
+		return h.w.Write(p)
+	}
+	h.held = append(h.held, p...)
+	if len(h.held) < h.limit {
+		return len(p), nil
+	}
+	return len(p), h.send()
+}
+
+// send starts the answer with what is held
+func (h *heldWriter) send() error {
+	h.sent = true
+	h.w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, err := h.w.Write(h.held)
+	h.held = nil
+	return err
+}
+
+// tooLarge reports whether err is a line or a request body past its limit
+func tooLarge(err error) bool {
+	// http.MaxBytesError is newer than the module's go version, its text
+	// is the same in every version
+	return errors.Is(err, bufio.ErrTooLong) || strings.Contains(err.Error(), "http: request body too large")
+}
+
+// handlePatterns lists the pattern set on GET, one pattern per line in the
+// extended syntax, and on POST, PUT and DELETE adds, replaces or removes
+// the patterns in the body
+func (s *patternServer) handlePatterns(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.mu.Lock()
+		lines := make([]string, len(s.rules))
+		for i, rule := range s.rules {
+			lines[i] = anot.FormatRule(rule)
+		}
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writeLines(w, lines, defaultWriteBuffer)
+		return
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+	default:
+		http.Error(w, "use GET, POST, PUT or DELETE", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// A slow or huge body holds up no one: it is parsed before the pattern
+	// set is locked
+	r.Body = http.MaxBytesReader(w, r.Body, s.maxBody)
+	posted, err := anot.ReadRules(r.Body, s.src.extended, s.src.trim)
+	if err != nil {
+		status := http.StatusBadRequest
+		if tooLarge(err) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, fmt.Sprintf("error parsing pattern: %s", err), status)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var rules []*anot.Rule
+	switch r.Method {
+	case http.MethodPost:
+		rules = append(append(rules, s.rules...), posted...)
+	case http.MethodPut:
+		rules = posted
+	case http.MethodDelete:
+		remove := make(map[string]bool, len(posted))
+		for _, rule := range posted {
+			remove[rule.Pattern] = true
+		}
+		for _, rule := range s.rules {
+			if !remove[rule.Pattern] {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	if err := s.replace(rules); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Fprintf(w, "%d patterns\n", len(s.rules))
+}
+
+// handleCompiled answers GET /compiled with the pattern set compiled as by
+// "anot compile", for "anot --server"; its hash is the ETag, so clients
+// with a current copy get a 304
+func (s *patternServer) handleCompiled(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "use GET", http.StatusMethodNotAllowed)
+		return
+	}
+	s.mu.Lock()
+	if s.compiled == nil {
+		c := anot.Compile(s.rules, s.live.Load())
+		c.KeepRules(s.rules)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(c); err != nil {
+			s.mu.Unlock()
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.compiled, s.compiledHash = buf.Bytes(), c.Hash
+	}
+	body, etag := s.compiled, strconv.Quote(s.compiledHash)
+	s.mu.Unlock()
+
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(body)
+}
+
+// handleReload answers POST /reload by loading the pattern files again
+func (s *patternServer) handleReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.reload(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.mu.Lock()
+	n := len(s.rules)
+	s.mu.Unlock()
+	fmt.Fprintf(w, "%d patterns\n", n)
+}
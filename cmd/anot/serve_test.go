@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+func TestHandleFilter(t *testing.T) {
+	rules, err := anot.ReadRules(strings.NewReader("b\n"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &patternServer{src: &patternSource{}, metrics: newMetrics(), maxBody: 4 << 20}
+	s.live.Store(anot.NewMatcher(rules))
+
+	many := strings.Repeat("a\nb\n", filterHoldBack)
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		want   string
+	}{
+		{"filtered", "a\nb\nc\n", http.StatusOK, "a\nc\n"},
+		{"streamed", many, http.StatusOK, strings.Repeat("a\n", filterHoldBack)},
+		{"line too long", "a\n" + strings.Repeat("x", anot.DefaultMaxLineSize+1) + "\n", http.StatusRequestEntityTooLarge, ""},
+		// Removed lines send nothing, so the error has its status
+		{"body too large", strings.Repeat("b\n", 3<<20), http.StatusRequestEntityTooLarge, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.handleFilter(rec, httptest.NewRequest(http.MethodPost, "/filter", strings.NewReader(tt.body)))
+			body, _ := io.ReadAll(rec.Body)
+			if rec.Code != tt.status {
+				t.Fatalf("status %d, want %d", rec.Code, tt.status)
+			}
+			if tt.status == http.StatusOK && string(body) != tt.want {
+				t.Errorf("body %q, want %q", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleCheckIPSet(t *testing.T) {
+	// Enough exact IPs for the matcher to keep them as sorted numbers
+	var b strings.Builder
+	for i := 0; i < 1<<16+1; i++ {
+		fmt.Fprintf(&b, "10.%d.%d.%d\n", i>>16, i>>8&0xff, i&0xff)
+	}
+	rules, err := anot.ReadRules(strings.NewReader(b.String()), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &patternServer{src: &patternSource{}, metrics: newMetrics()}
+	s.live.Store(anot.NewMatcher(rules))
+
+	rec := httptest.NewRecorder()
+	s.handleCheck(rec, httptest.NewRequest(http.MethodGet, "/check?value=10.0.1.2&value=10.9.9.9", nil))
+	var results []checkResult
+	if err := json.NewDecoder(rec.Body).Decode(&results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("%d results, want 2", len(results))
+	}
+	if !results[0].Matched || results[0].Pattern != "10.0.1.2" || results[0].Kept {
+		t.Errorf("10.0.1.2: %+v", results[0])
+	}
+	if results[1].Matched || results[1].Pattern != "" || !results[1].Kept {
+		t.Errorf("10.9.9.9: %+v", results[1])
+	}
+}
+
+func TestHandleFilterFailsMidStream(t *testing.T) {
+	s := &patternServer{src: &patternSource{}, metrics: newMetrics(), maxBody: 4 << 20}
+	s.live.Store(anot.NewMatcher(nil))
+	srv := httptest.NewServer(http.HandlerFunc(s.handleFilter))
+	defer srv.Close()
+
+	// Past the held back start of the answer, a failure breaks it off
+	body := strings.Repeat("a\n", filterHoldBack) + strings.Repeat("x", anot.DefaultMaxLineSize+1) + "\n"
+	resp, err := http.Post(srv.URL, "text/plain", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d, want the answer started", resp.StatusCode)
+	}
+	if _, err := io.ReadAll(resp.Body); err == nil {
+		t.Error("a broken off answer read as complete")
+	}
+}
+
+func TestHandlePatterns(t *testing.T) {
+	s := &patternServer{src: &patternSource{}, metrics: newMetrics(), maxBody: 64}
+	if err := s.replace(nil); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		method string
+		body   string
+		status int
+		want   string
+	}{
+		{http.MethodPost, "a\nb\n", http.StatusOK, "2 patterns\n"},
+		{http.MethodPost, strings.Repeat("c\n", 64), http.StatusRequestEntityTooLarge, ""},
+		{http.MethodDelete, "a\n", http.StatusOK, "1 patterns\n"},
+		{http.MethodGet, "", http.StatusOK, "b\n"},
+		{http.MethodPut, "x\ny\n", http.StatusOK, "2 patterns\n"},
+		{http.MethodPatch, "", http.StatusMethodNotAllowed, ""},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		s.handlePatterns(rec, httptest.NewRequest(tt.method, "/patterns", strings.NewReader(tt.body)))
+		if rec.Code != tt.status {
+			t.Fatalf("%s: status %d, want %d", tt.method, rec.Code, tt.status)
+		}
+		if tt.want != "" && rec.Body.String() != tt.want {
+			t.Errorf("%s: body %q, want %q", tt.method, rec.Body.String(), tt.want)
+		}
+	}
+}
+
+func TestHandlePatternsSlowBody(t *testing.T) {
+	s := &patternServer{src: &patternSource{}, metrics: newMetrics(), maxBody: 1 << 20}
+	if err := s.replace(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A body still on its way doesn't lock the pattern set
+	body, writer := io.Pipe()
+	done := make(chan int)
+	go func() {
+		rec := httptest.NewRecorder()
+		s.handlePatterns(rec, httptest.NewRequest(http.MethodPost, "/patterns", body))
+		done <- rec.Code
+	}()
+	writer.Write([]byte("a\n"))
+
+	listed := make(chan struct{})
+	go func() {
+		s.handlePatterns(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/patterns", nil))
+		close(listed)
+	}()
+	select {
+	case <-listed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("GET /patterns waited for a POST body")
+	}
+	writer.Close()
+	if code := <-done; code != http.StatusOK {
+		t.Errorf("POST status %d", code)
+	}
+}
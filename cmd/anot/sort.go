@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// checkSortOrder reports whether order is one sortLines knows, so a bad
+// -sort is refused before any file is touched
+func checkSortOrder(order string) error {
+	switch order {
+	case "lex", "natural", "ip":
+		return nil
+	}
+	return fmt.Errorf("unknown sort order %q (want lex, ip or natural)", order)
+}
+
+// sortLines sorts lines in place using the named order:
+//
+//	lex      plain byte-wise order
+//	natural  digit runs compare numerically, so host2 sorts before host10
+//	ip       IPv4 then IPv6 addresses numerically, followed by other lines in natural order
+func sortLines(lines []string, order string, trim bool) error {
+	key := func(line string) string {
+		if trim {
+			return strings.TrimSpace(line)
+		}
+		return line
+	}
+
+	switch order {
+	case "lex":
+		sort.SliceStable(lines, func(i, j int) bool {
+			return key(lines[i]) < key(lines[j])
+		})
+	case "natural":
+		sort.SliceStable(lines, func(i, j int) bool {
+			return naturalLess(key(lines[i]), key(lines[j]))
+		})
+	case "ip":
+		// Parse every line once up front rather than on each comparison
+		ips := make(map[string]net.IP, len(lines))
+		for _, line := range lines {
+			if ip := net.ParseIP(key(line)); ip != nil {
+				ips[line] = ip
+			}
+		}
+		sort.SliceStable(lines, func(i, j int) bool {
+			a, b := ips[lines[i]], ips[lines[j]]
+			switch {
+			case a != nil && b != nil:
+				return ipLess(a, b)
+			case a != nil:
+				return true
+			case b != nil:
+				return false
+			}
+			return naturalLess(key(lines[i]), key(lines[j]))
+		})
+	default:
+		return checkSortOrder(order)
+	}
+	return nil
+}
+
+// ipLess orders IPv4 addresses before IPv6 ones, each numerically
+func ipLess(a, b net.IP) bool {
+	a4, b4 := a.To4(), b.To4()
+	if (a4 != nil) != (b4 != nil) {
+		return a4 != nil
+	}
+	return bytes.Compare(a.To16(), b.To16()) < 0
+}
+
+// naturalLess compares strings treating runs of digits as numbers
+func naturalLess(a, b string) bool {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			na, ra := splitDigits(a)
+			nb, rb := splitDigits(b)
+			// Compare numerically: ignore leading zeros, then longer is bigger
+			ta, tb := strings.TrimLeft(na, "0"), strings.TrimLeft(nb, "0")
+			if len(ta) != len(tb) {
+				return len(ta) < len(tb)
+			}
+			if ta != tb {
+				return ta < tb
+			}
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			a, b = ra, rb
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b)
+}
+
+// splitDigits splits the leading run of digits off s
+func splitDigits(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// uniqueLines removes every repeated line, keeping first occurrences in
+// order, and returns how many duplicates were collapsed
+func uniqueLines(lines []string, trim bool) ([]string, int) {
+	seen := make(map[string]bool, len(lines))
+	unique := lines[:0]
+	for _, line := range lines {
+		key := line
+		if trim {
+			key = strings.TrimSpace(line)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, line)
+	}
+	return unique, len(lines) - len(unique)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnknownSortOrder(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "hosts.txt")
+	if err := os.WriteFile(fn, []byte("b\na\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "scope.txt"), []byte("b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	status, stderr := runAnot(t, dir, "", "-p", "scope.txt", "-sort", "size", "-q", fn)
+	if status != 2 {
+		t.Errorf("exit status %d, want 2; stderr:\n%s", status, stderr)
+	}
+	if data, _ := os.ReadFile(fn); string(data) != "b\na\n" {
+		t.Errorf("the file was changed to %q", data)
+	}
+}
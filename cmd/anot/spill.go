@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hasshido/anot"
+	"github.com/hasshido/anot/internal/bloom"
+)
+
+const (
+	// spillOverhead estimates what an in-memory exact pattern costs besides
+	// its bytes: the map entry, the Rule and the pointers to it
+	spillOverhead = 80
+	// spillIndexEvery is how many table records share one in-memory index
+	// entry, so a lookup reads one block of about that many records
+	spillIndexEvery = 256
+)
+
+// spillable reports whether a pattern is a plain exact match that can live
+// in the disk-backed set. Everything the matcher or the online checks treat
+// specially stays in memory.
+func spillable(pattern string) bool {
+	switch anot.PatternType(pattern) {
+	case "wildcard", "oui", "mac":
+		return false
+	}
+	return !strings.ContainsAny(pattern, ":/")
+}
+
+// loadRulesSpilling is loadRules keeping the exact patterns in memory only
+// up to budget bytes. Past that they all move to a disk-backed set, which
+// is returned along with the rules staying in memory.
+func loadRulesSpilling(patternFiles []string, extended, trim bool, budget int) ([]*anot.Rule, *diskSet, error) {
+	var rules []*anot.Rule
+	var builder *spillBuilder
+	size := 0
+	add := func(rule *anot.Rule) error {
+		if !spillable(rule.Pattern) {
+			rules = append(rules, rule)
+			return nil
+		}
+		if builder != nil {
+			return builder.add(rule)
+		}
+		rules = append(rules, rule)
+		if size += len(rule.Pattern) + len(rule.Arg) + spillOverhead; size <= budget {
+			return nil
+		}
+
+		// Over budget: move the exact patterns read so far to disk
+		builder = &spillBuilder{budget: budget}
+		kept := rules[:0]
+		for _, r := range rules {
+			if !spillable(r.Pattern) {
+				kept = append(kept, r)
+			} else if err := builder.add(r); err != nil {
+				return err
+			}
+		}
+		rules = kept
+		return nil
+	}
+
+	read := func(r io.Reader) error {
+		return anot.EachRule(r, extended, trim, add)
+	}
+	if len(patternFiles) == 0 {
+		promptPatterns()
+		if err := read(os.Stdin); err != nil {
+			builder.abort()
+			return nil, nil, err
+		}
+	}
+	for _, fn := range patternFiles {
+		f, err := os.Open(fn)
+		if err != nil {
+			builder.abort()
+			return nil, nil, err
+		}
+		err = read(f)
+		f.Close()
+		if err != nil {
+			builder.abort()
+			return nil, nil, fmt.Errorf("%s: %s", fn, err)
+		}
+	}
+
+	if builder == nil {
+		return rules, nil, nil
+	}
+	set, err := builder.finish()
+	if err != nil {
+		return nil, nil, err
+	}
+	return rules, set, nil
+}
+
+// spillRecord is an exact rule on its way to disk; seq is its position in
+// the pattern list, so the first of duplicate patterns still wins
+type spillRecord struct {
+	seq  uint64
+	rule anot.Rule
+}
+
+// spillBuilder builds a diskSet by external sorting: rules are collected
+// up to the memory budget, sorted and written out as a run, and the runs
+// are finally merged into one sorted table
+type spillBuilder struct {
+	budget int
+	batch  []spillRecord
+	size   int
+	seq    uint64
+	count  int
+	runs   []string
+}
+
+func (b *spillBuilder) add(rule *anot.Rule) error {
+	b.batch = append(b.batch, spillRecord{seq: b.seq, rule: *rule})
+	b.seq++
+	b.count++
+	if b.size += len(rule.Pattern) + len(rule.Arg) + spillOverhead; b.size > b.budget {
+		return b.flush()
+	}
+	return nil
+}
+
+// flush writes the collected rules to a new sorted run
+func (b *spillBuilder) flush() error {
+	if len(b.batch) == 0 {
+		return nil
+	}
+	sort.Slice(b.batch, func(i, j int) bool {
+		if b.batch[i].rule.Pattern != b.batch[j].rule.Pattern {
+			return b.batch[i].rule.Pattern < b.batch[j].rule.Pattern
+		}
+		return b.batch[i].seq < b.batch[j].seq
+	})
+
+	f, err := os.CreateTemp("", "anot-run-*")
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, f.Name())
+	w := bufio.NewWriter(f)
+	for _, rec := range b.batch {
+		writeUvarint(w, rec.seq)
+		writeRecord(w, &rec.rule)
+	}
+	err = w.Flush()
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	b.batch, b.size = b.batch[:0], 0
+	return err
+}
+
+// abort removes what was written so far; a nil builder does nothing
+func (b *spillBuilder) abort() {
+	if b == nil {
+		return
+	}
+	for _, run := range b.runs {
+		os.Remove(run)
+	}
+}
+
+// finish merges the runs into the table of a new diskSet
+func (b *spillBuilder) finish() (*diskSet, error) {
+	defer b.abort()
+	if err := b.flush(); err != nil {
+		return nil, err
+	}
+	b.batch = nil
+
+	merge := &runMerge{}
+	for _, run := range b.runs {
+		f, err := os.Open(run)
+		if err != nil {
+			merge.close()
+			return nil, err
+		}
+		src := &runReader{f: f, r: bufio.NewReader(f)}
+		if err := merge.push(src); err != nil {
+			merge.close()
+			return nil, err
+		}
+	}
+	defer merge.close()
+
+	f, err := os.CreateTemp("", "anot-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	set := &diskSet{f: f, bloom: bloom.New(b.count)}
+	w := &countingWriter{w: bufio.NewWriter(f)}
+	n := 0
+	last := ""
+	for merge.Len() > 0 {
+		src := merge.sources[0]
+		rec := src.rec
+		if err := merge.advance(); err != nil {
+			set.Close()
+			return nil, err
+		}
+		// Runs are sorted by pattern and position, the first one wins
+		if n > 0 && rec.rule.Pattern == last {
+			continue
+		}
+		if n%spillIndexEvery == 0 {
+			set.keys = append(set.keys, rec.rule.Pattern)
+			set.offsets = append(set.offsets, w.n)
+		}
+		writeRecord(w, &rec.rule)
+		set.bloom.Add(rec.rule.Pattern)
+		last = rec.rule.Pattern
+		n++
+	}
+	if err := w.w.Flush(); err != nil {
+		set.Close()
+		return nil, err
+	}
+	set.size = w.n
+	return set, nil
+}
+
+// runReader reads the records of a run one by one
+type runReader struct {
+	f   *os.File
+	r   *bufio.Reader
+	rec spillRecord
+}
+
+func (s *runReader) next() error {
+	seq, err := binary.ReadUvarint(s.r)
+	if err != nil {
+		return err
+	}
+	s.rec.seq = seq
+	return readRecord(s.r, &s.rec.rule)
+}
+
+// runMerge is a min-heap of runs ordered by their current record
+type runMerge struct {
+	sources []*runReader
+}
+
+func (m *runMerge) Len() int { return len(m.sources) }
+func (m *runMerge) Less(i, j int) bool {
+	a, b := &m.sources[i].rec, &m.sources[j].rec
+	if a.rule.Pattern != b.rule.Pattern {
+		return a.rule.Pattern < b.rule.Pattern
+	}
+	return a.seq < b.seq
+}
+func (m *runMerge) Swap(i, j int)      { m.sources[i], m.sources[j] = m.sources[j], m.sources[i] }
+func (m *runMerge) Push(x interface{}) { m.sources = append(m.sources, x.(*runReader)) }
+func (m *runMerge) Pop() interface{} {
+	src := m.sources[len(m.sources)-1]
+	m.sources = m.sources[:len(m.sources)-1]
+	return src
+}
+
+// push adds a run positioned on its first record
+func (m *runMerge) push(src *runReader) error {
+	if err := src.next(); err != nil {
+		src.f.Close()
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	heap.Push(m, src)
+	return nil
+}
+
+// advance moves the run holding the smallest record to its next record
+func (m *runMerge) advance() error {
+	src := m.sources[0]
+	if err := src.next(); err != nil {
+		heap.Pop(m)
+		src.f.Close()
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	heap.Fix(m, 0)
+	return nil
+}
+
+func (m *runMerge) close() {
+	for _, src := range m.sources {
+		src.f.Close()
+	}
+	m.sources = nil
+}
+
+// diskSet is an exact-match set kept in a sorted table file. In memory
+// there are only a bloom filter, which answers most lookups, and the first
+// pattern of every block of spillIndexEvery records.
+type diskSet struct {
+	f       *os.File
+	size    int64
+	bloom   *bloom.Filter
+	keys    []string
+	offsets []int64
+}
+
+// Get returns the rule of an exact pattern, or nil. Lookups only read the
+// file, so they are safe for concurrent use.
+func (s *diskSet) Get(pattern string) *anot.Rule {
+	if !s.bloom.MayContain(pattern) {
+		return nil
+	}
+	i := sort.Search(len(s.keys), func(i int) bool { return s.keys[i] > pattern }) - 1
+	if i < 0 {
+		return nil
+	}
+	end := s.size
+	if i+1 < len(s.offsets) {
+		end = s.offsets[i+1]
+	}
+	block := make([]byte, end-s.offsets[i])
+	if _, err := s.f.ReadAt(block, s.offsets[i]); err != nil {
+		// A table that can't be read keeps the line, losing nothing
+		return nil
+	}
+
+	r := &byteReader{b: block}
+	for len(r.b) > 0 {
+		rule := &anot.Rule{}
+		if readRecord(r, rule) != nil {
+			return nil
+		}
+		if rule.Pattern == pattern {
+			return rule
+		}
+		if rule.Pattern > pattern {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Close closes and removes the table file
+func (s *diskSet) Close() error {
+	err := s.f.Close()
+	if removeErr := os.Remove(s.f.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
+
+// writeRecord writes a rule as length-prefixed pattern, action and argument
+func writeRecord(w io.Writer, rule *anot.Rule) {
+	writeString(w, rule.Pattern)
+	writeUvarint(w, uint64(rule.Action))
+	writeString(w, rule.Arg)
+}
+
+// readRecord reads a rule written by writeRecord
+func readRecord(r io.ByteReader, rule *anot.Rule) error {
+	pattern, err := readString(r)
+	if err != nil {
+		return err
+	}
+	action, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	arg, err := readString(r)
+	if err != nil {
+		return err
+	}
+	rule.Pattern, rule.Action, rule.Arg = pattern, anot.Action(action), arg
+	return nil
+}
+
+func writeUvarint(w io.Writer, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func writeString(w io.Writer, s string) {
+	writeUvarint(w, uint64(len(s)))
+	io.WriteString(w, s)
+}
+
+func readString(r io.ByteReader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	for i := range b {
+		if b[i], err = r.ReadByte(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+	}
+	return string(b), nil
+}
+
+// byteReader reads from a block of a table file
+type byteReader struct {
+	b []byte
+}
+
+func (r *byteReader) ReadByte() (byte, error) {
+	if len(r.b) == 0 {
+		return 0, io.EOF
+	}
+	c := r.b[0]
+	r.b = r.b[1:]
+	return c, nil
+}
+
+// countingWriter tracks the offset of what is written to the table
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestLoadRulesSpilling(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("*.example.com tag wild\n10.0.0.0/8\n")
+	for i := 0; i < 3000; i++ {
+		fmt.Fprintf(&b, "host%d.example.org\n", i)
+	}
+	// Duplicates in later runs lose to the first occurrence
+	b.WriteString("host7.example.org replace later\ndup.example.net tag first\ndup.example.net tag second\n")
+	fn := filepath.Join(t.TempDir(), "patterns.txt")
+	if err := os.WriteFile(fn, []byte(b.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		budget  int
+		spilled bool
+	}{
+		{"within budget", 1 << 30, false},
+		{"over budget", 16 << 10, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, set, err := loadRulesSpilling([]string{fn}, true, false, tt.budget)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (set != nil) != tt.spilled {
+				t.Fatalf("spilled = %v, want %v", set != nil, tt.spilled)
+			}
+			m := anot.NewMatcher(rules)
+			if set != nil {
+				defer set.Close()
+				if len(rules) != 2 {
+					t.Errorf("%d rules kept in memory, want the wildcard and the CIDR", len(rules))
+				}
+				m.SetExactSet(set)
+			}
+
+			for _, c := range []struct {
+				line   string
+				action anot.Action
+				arg    string
+			}{
+				{"host0.example.org", anot.ActionRemove, ""},
+				{"host2999.example.org", anot.ActionRemove, ""},
+				{"host7.example.org", anot.ActionRemove, ""},
+				{"dup.example.net", anot.ActionTag, "first"},
+				{"a.example.com", anot.ActionTag, "wild"},
+				{"10.1.2.3", anot.ActionRemove, ""},
+			} {
+				rule := m.Match(c.line)
+				if rule == nil {
+					t.Errorf("%s not matched", c.line)
+				} else if rule.Action != c.action || rule.Arg != c.arg {
+					t.Errorf("%s matched %s %q, want %s %q", c.line, rule.Action, rule.Arg, c.action, c.arg)
+				}
+			}
+			for _, line := range []string{"host3000.example.org", "example.org", "", "zzz"} {
+				if rule := m.Match(line); rule != nil {
+					t.Errorf("%q matched %q", line, rule.Pattern)
+				}
+			}
+		})
+	}
+}
+
+func TestSpillable(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{"host.example.com", true},
+		{"10.0.0.1", true},
+		{"*.example.com", false},
+		{"10.0.0.0/8", false},
+		{"2001:db8::1", false},
+		{":443", false},
+		{"00:1A:2B:*", false},
+		{"asn:64500", false},
+	}
+	for _, tt := range tests {
+		if got := spillable(tt.pattern); got != tt.want {
+			t.Errorf("spillable(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+)
+
+// runStats measures a run for --stats: how much input it went through, how
+// fast, and what memory it took
+type runStats struct {
+	start time.Time
+	bytes int64
+}
+
+func newRunStats() *runStats {
+	return &runStats{start: time.Now()}
+}
+
+// addFiles counts the size of the target files as processed input
+func (s *runStats) addFiles(files []string) {
+	for _, fn := range files {
+		if info, err := os.Stat(fn); err == nil {
+			s.bytes += info.Size()
+		}
+	}
+}
+
+// write prints the statistics on a single line
+func (s *runStats) write(w io.Writer) {
+	elapsed := time.Since(s.start)
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	const mb = 1 << 20
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(s.bytes) / mb / elapsed.Seconds()
+	}
+	fmt.Fprintf(w, "stats: %.1f MB in %s (%.1f MB/s), allocated %.1f MB", float64(s.bytes)/mb, elapsed.Round(time.Millisecond), rate, float64(mem.TotalAlloc)/mb)
+	if rss, ok := peakRSS(); ok {
+		fmt.Fprintf(w, ", peak RSS %.1f MB", float64(rss)/mb)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// checkpointEvery is how many lines pass between two checkpoints
+const checkpointEvery = 100000
+
+// stopCheckEvery is how many lines pass between two looks at --timeout
+const stopCheckEvery = 4096
+
+// checkpoint records how far a --stream run got: the input offset up to
+// which lines were handled, and how much of the temporary output holds
+// their surviving lines. The input's size and modification time tell
+// whether the checkpoint still belongs to it.
+type checkpoint struct {
+	Input        string `json:"input"`
+	Size         int64  `json:"size"`
+	ModTime      int64  `json:"mtime"`
+	Output       string `json:"output,omitempty"`
+	InputOffset  int64  `json:"input_offset"`
+	OutputOffset int64  `json:"output_offset"`
+}
+
+// streamConflicts returns the enabled options that need the whole file in
+// memory and can't be combined with --stream
+func streamConflicts(j *filterJob) []string {
+	var conflicts []string
+	for name, enabled := range map[string]bool{
+		"-csv":               j.csvMode,
+		"-hosts-file":        j.hostsMode,
+		"-zone-file":         j.zoneMode,
+		"-nmap-xml":          j.nmapXML,
+		"-with-comments":     j.withComments,
+		"-dedupe":            j.dedupe,
+		"-sort":              j.sortOrder != "",
+		"-u":                 j.unique || j.reportDupes,
+		"-min-count":         j.minCount > 0,
+		"-max-count":         j.maxCount > 0,
+		"-summarize-removed": j.summarizeRemoved,
+		"-report":            j.report != "",
+		"-preset":            j.fixup != nil,
+		"online checks":      len(j.checks) > 0,
+	} {
+		if enabled {
+			conflicts = append(conflicts, name)
+		}
+	}
+	return conflicts
+}
+
+// loadCheckpoint returns the checkpoint saved in fn for the input, or nil
+// when there is none or it belongs to another input or version of it
+func loadCheckpoint(fn, input string, info os.FileInfo) (*checkpoint, error) {
+	data, err := os.ReadFile(fn)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("%s: not a checkpoint file: %s", fn, err)
+	}
+	if cp.Input != input || cp.Size != info.Size() || cp.ModTime != info.ModTime().UnixNano() {
+		return nil, nil
+	}
+	if cp.Output != "" {
+		if _, err := os.Stat(cp.Output); err != nil {
+			return nil, nil
+		}
+	}
+	return &cp, nil
+}
+
+// save writes the checkpoint atomically, so a crash leaves the previous one
+func (cp *checkpoint) save(fn string) error {
+	return writeAtomic(fn, func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(cp)
+	})
+}
+
+// runStream filters a file line by line in bounded memory, the surviving
+// lines going to a temporary file copied over the target at the end.
+// With a checkpoint file, progress is recorded every checkpointEvery lines
+// and on interrupt, and a later run with the same checkpoint resumes there.
+func (j *filterJob) runStream(fn string, stdout, stderr io.Writer) error {
+	path, err := filepath.EvalSymlinks(fn)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+	if path, err = filepath.Abs(path); err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+	in, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+
+	cp := &checkpoint{Input: path, Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+	if j.checkpoint != "" {
+		saved, err := loadCheckpoint(j.checkpoint, path, info)
+		if err != nil {
+			return err
+		}
+		if saved != nil {
+			cp = saved
+			fmt.Fprintf(stderr, "resuming %s at byte %d\n", fn, cp.InputOffset)
+		}
+	}
+	if _, err := in.Seek(cp.InputOffset, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read file: %s", err)
+	}
+
+	var out *os.File
+	if !j.dryRun {
+		if cp.Output != "" {
+			out, err = os.OpenFile(cp.Output, os.O_WRONLY, 0)
+			if err == nil {
+				// Drop what was written after the checkpoint
+				err = out.Truncate(cp.OutputOffset)
+			}
+			if err == nil {
+				_, err = out.Seek(cp.OutputOffset, io.SeekStart)
+			}
+		} else {
+			out, err = os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".anot-*")
+			if err == nil {
+				cp.Output = out.Name()
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write file: %s", err)
+		}
+		defer out.Close()
+	}
+	// Without a checkpoint a failed run has no use for its partial output
+	completed := false
+	defer func() {
+		if out != nil && !completed && j.checkpoint == "" {
+			os.Remove(cp.Output)
+		}
+	}()
+	var w *bufio.Writer
+	if out != nil {
+		w = bufio.NewWriterSize(out, j.writeBuffer)
+	}
+	printed := bufio.NewWriterSize(stdout, j.writeBuffer)
+	defer printed.Flush()
+
+	keys := j.wrapKeys(j.keys)
+
+	// removedLines are the lines to move since the last commit, collected
+	// only with -move-to
+	var removedLines []string
+	// Moved lines are dropped as they are committed, so the notification
+	// counts and samples them on its own
+	var removed, survived int
+	var sample []string
+	// commit makes everything handled so far durable, and records it in a
+	// checkpoint unless the run is complete
+	commit := func(save bool) error {
+		printed.Flush()
+		if w != nil {
+			if err := w.Flush(); err != nil {
+				return fmt.Errorf("failed to write file: %s", err)
+			}
+			if err := out.Sync(); err != nil {
+				return fmt.Errorf("failed to write file: %s", err)
+			}
+			if cp.OutputOffset, err = out.Seek(0, io.SeekCurrent); err != nil {
+				return fmt.Errorf("failed to write file: %s", err)
+			}
+		}
+		// Moving is anew-style, so lines moved again after a resume are no harm
+		if j.moveTo != "" && len(removedLines) > 0 && !j.dryRun {
+			j.moveMu.Lock()
+			err := appendNewLines(j.moveTo, removedLines)
+			j.moveMu.Unlock()
+			if err != nil {
+				return fmt.Errorf("failed to move lines to %s: %s", j.moveTo, err)
+			}
+		}
+		removedLines = removedLines[:0]
+		if save && j.checkpoint != "" {
+			if err := cp.save(j.checkpoint); err != nil {
+				return fmt.Errorf("failed to save checkpoint: %s", err)
+			}
+		}
+		return nil
+	}
+
+	var prog *progress
+	if j.progress {
+		prog = newProgress(stderr, fn)
+		prog.start("filtering", "MB", info.Size())
+		prog.add(int(cp.InputOffset))
+		defer prog.finish()
+	}
+
+	r := bufio.NewReaderSize(in, 1<<20)
+	for n := 1; ; n++ {
+		raw, readErr := r.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return fmt.Errorf("failed to read file: %s", readErr)
+		}
+		if raw == "" {
+			break
+		}
+		cp.InputOffset += int64(len(raw))
+		prog.add(len(raw))
+		line := strings.TrimSuffix(strings.TrimSuffix(raw, "\n"), "\r")
+
+		if kept, keep := j.filterLine(line, keys); keep {
+			if !j.quietMode {
+				printed.WriteString(kept)
+				printed.WriteByte('\n')
+			}
+			if w != nil {
+				w.WriteString(kept)
+				w.WriteByte('\n')
+			}
+			survived++
+		} else {
+			if j.moveTo != "" && !j.dryRun {
+				removedLines = append(removedLines, line)
+			}
+			if removed++; len(sample) < webhookSamples {
+				sample = append(sample, line)
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		// A timeout or an interrupt stops with a last checkpoint
+		if n%stopCheckEvery == 0 && stoppedError(j.ctx, "") != nil {
+			if j.checkpoint == "" {
+				return stoppedError(j.ctx, "left unchanged")
+			}
+			if err := commit(true); err != nil {
+				return err
+			}
+			return stoppedError(j.ctx, "run again with the same -checkpoint to resume")
+		}
+		if j.checkpoint != "" && n%checkpointEvery == 0 {
+			if err := commit(true); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := commit(false); err != nil {
+		return err
+	}
+	if out != nil {
+		if err := out.Chmod(info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write file: %s", err)
+		}
+		if err := out.Close(); err != nil {
+			return fmt.Errorf("failed to write file: %s", err)
+		}
+		if err := replaceInPlace(cp.Output, path); err != nil {
+			return fmt.Errorf("failed to write file: %s", err)
+		}
+	}
+	completed = true
+	if j.checkpoint != "" {
+		// The run is complete, nothing left to resume
+		os.Remove(j.checkpoint)
+	}
+	j.notifyRemovals(fn, removed, survived, sample, stderr)
+	j.recordRemovals(fn, removed)
+	return nil
+}
+
+// matchKeys returns the rule matching the keys of a line, combined as the
+// preset says
+func (j *filterJob) matchKeys(m *anot.Matcher, keys []string) *anot.Rule {
+	if j.match != nil {
+		return j.match(m, keys)
+	}
+	return m.MatchKeys(keys)
+}
+
+// filterLine applies the rule matching the keys of a single line, nil for
+// the whole line, returning the line to write and whether it survives
+func (j *filterJob) filterLine(line string, keys keyFunc) (string, bool) {
+	key := line
+	if j.trim {
+		key = strings.TrimSpace(line)
+	}
+	var rule *anot.Rule
+	if keys == nil {
+		rule = j.matcher.Match(key)
+	} else {
+		lineKeys := keys(line)
+		if j.trim {
+			for i := range lineKeys {
+				lineKeys[i] = strings.TrimSpace(lineKeys[i])
+			}
+		}
+		rule = j.matchKeys(j.matcher, lineKeys)
+	}
+	if j.keepMatched {
+		// Matched lines survive untouched, everything else goes
+		return line, rule != nil
+	}
+	if rule == nil {
+		return line, true
+	}
+	return rule.Apply(line)
+}
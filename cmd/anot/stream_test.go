@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamCheckpointResume(t *testing.T) {
+	const input = "a\nb\nc\nd\nb\ne\n"
+	tests := []struct {
+		name string
+		// stale makes the checkpoint belong to an older version of the input
+		stale    bool
+		resuming bool
+		want     string
+	}{
+		{"resume", false, true, "x\nc\nd\ne\n"},
+		{"input changed", true, false, "a\nc\nd\ne\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "oos.txt"), []byte("b\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			fn := filepath.Join(dir, "input.txt")
+			if err := os.WriteFile(fn, []byte(input), 0644); err != nil {
+				t.Fatal(err)
+			}
+			path, err := filepath.EvalSymlinks(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// An interrupted run handled "a\nb\nc\n" and wrote more output
+			// after its last checkpoint. The output up to the checkpoint
+			// says "x" for "a", so a resumed run shows it never read the
+			// first lines again.
+			output := filepath.Join(dir, ".input.txt.anot-1")
+			if err := os.WriteFile(output, []byte("x\nc\nd\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			cp := checkpoint{Input: path, Size: info.Size(), ModTime: info.ModTime().UnixNano(),
+				Output: output, InputOffset: int64(len("a\nb\nc\n")), OutputOffset: int64(len("x\nc\n"))}
+			if tt.stale {
+				cp.ModTime = info.ModTime().Add(-time.Hour).UnixNano()
+			}
+			data, err := json.Marshal(cp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cpFile := filepath.Join(dir, "run.ckpt")
+			if err := os.WriteFile(cpFile, data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			status, stderr := runAnot(t, dir, "", "-checkpoint", cpFile, "-q", "-p", "oos.txt", "input.txt")
+			if status != 0 {
+				t.Fatalf("exit status %d; stderr:\n%s", status, stderr)
+			}
+			if got := strings.Contains(stderr, "resuming"); got != tt.resuming {
+				t.Errorf("resuming = %v, want %v; stderr:\n%s", got, tt.resuming, stderr)
+			}
+			if data, _ := os.ReadFile(fn); string(data) != tt.want {
+				t.Errorf("file %q, want %q", data, tt.want)
+			}
+			if _, err := os.Stat(cpFile); !os.IsNotExist(err) {
+				t.Error("the checkpoint of a complete run was kept")
+			}
+		})
+	}
+}
+
+func TestStreamCheckpointCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string]string{"oos.txt": "b\n", "input.txt": "a\nb\n", "run.ckpt": "not json"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if status, _ := runAnot(t, dir, "", "-checkpoint", "run.ckpt", "-q", "-p", "oos.txt", "input.txt"); status == 0 {
+		t.Error("a corrupt checkpoint was ignored")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "input.txt")); string(data) != "a\nb\n" {
+		t.Errorf("file %q changed", data)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+	"sort"
+)
+
+// u128 is an IP address as an unsigned 128-bit integer
+type u128 struct {
+	hi, lo uint64
+}
+
+func (a u128) less(b u128) bool {
+	return a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo)
+}
+
+func (a u128) add(b u128) u128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, carry)
+	return u128{hi, lo}
+}
+
+// trailingZeros counts the trailing zero bits, 128 for zero
+func (a u128) trailingZeros() int {
+	if a.lo != 0 {
+		return bits.TrailingZeros64(a.lo)
+	}
+	return 64 + bits.TrailingZeros64(a.hi)
+}
+
+// mask returns 2^n - 1
+func mask(n int) u128 {
+	switch {
+	case n >= 128:
+		return u128{^uint64(0), ^uint64(0)}
+	case n >= 64:
+		return u128{1<<uint(n-64) - 1, ^uint64(0)}
+	default:
+		return u128{0, 1<<uint(n) - 1}
+	}
+}
+
+func ipToU128(ip net.IP) u128 {
+	ip = ip.To16()
+	return u128{binary.BigEndian.Uint64(ip[:8]), binary.BigEndian.Uint64(ip[8:])}
+}
+
+func u128ToIP(a u128, width int) net.IP {
+	ip := make(net.IP, 16)
+	binary.BigEndian.PutUint64(ip[:8], a.hi)
+	binary.BigEndian.PutUint64(ip[8:], a.lo)
+	if width == 32 {
+		return ip[12:]
+	}
+	return ip
+}
+
+// summarizeIPs aggregates addresses into the minimal list of CIDR blocks
+// covering exactly those addresses, IPv4 blocks first
+func summarizeIPs(ips []net.IP) []string {
+	var v4, v6 []u128
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			v4 = append(v4, u128{0, uint64(binary.BigEndian.Uint32(ip4))})
+		} else if ip.To16() != nil {
+			v6 = append(v6, ipToU128(ip))
+		}
+	}
+	return append(summarizeRange(v4, 32), summarizeRange(v6, 128)...)
+}
+
+// summarizeRange merges addresses of the given bit width into CIDR blocks
+func summarizeRange(addrs []u128, width int) []string {
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].less(addrs[j]) })
+
+	var blocks []string
+	one := u128{0, 1}
+	for i := 0; i < len(addrs); {
+		// Extend a run of consecutive addresses (duplicates included)
+		start, end := addrs[i], addrs[i]
+		for i++; i < len(addrs); i++ {
+			if addrs[i] != end && addrs[i] != end.add(one) {
+				break
+			}
+			end = addrs[i]
+		}
+
+		// Cover [start, end] with the largest aligned blocks that fit
+		for {
+			size := start.trailingZeros()
+			if size > width {
+				size = width
+			}
+			for size > 0 && end.less(start.add(mask(size))) {
+				size--
+			}
+			blocks = append(blocks, fmt.Sprintf("%s/%d", u128ToIP(start, width), width-size))
+
+			last := start.add(mask(size))
+			if last == end {
+				break
+			}
+			start = last.add(one)
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+// runSync implements "anot sync": candidate lines read from stdin are
+// appended to the file when they are neither already present nor matched by
+// the removal patterns, combining anew and anot in one write
+func runSync(args []string) error {
+	fs := flag.NewFlagSet("anot sync", flag.ExitOnError)
+	var patternFiles stringList
+	var quietMode bool
+	var dryRun bool
+	var trim bool
+	var extended bool
+	fs.Var(&patternFiles, "p", "read removal patterns from this file (repeatable, required)")
+	fs.BoolVar(&quietMode, "q", false, "quiet mode (don't print the added lines)")
+	fs.BoolVar(&dryRun, "d", false, "don't write to file, just print the lines that would be added")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot sync -p patterns.txt [options] <filename> < candidates.txt\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	fn := fs.Arg(0)
+	if fn == "" {
+		return usageError("no filename provided")
+	}
+	if len(patternFiles) == 0 {
+		// stdin carries the candidates, so patterns must come from files
+		return usageError("sync needs at least one -p pattern file")
+	}
+
+	rules, err := loadRules(patternFiles, extended, trim)
+	if err != nil {
+		return patternsError(err)
+	}
+	matcher := anot.NewMatcher(rules)
+
+	existing, err := readLines(fn)
+	if err != nil && !os.IsNotExist(err) {
+		return ioError(fn, fmt.Errorf("failed to read file: %s", err))
+	}
+
+	key := func(line string) string {
+		if trim {
+			return strings.TrimSpace(line)
+		}
+		return line
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, line := range existing {
+		seen[key(line)] = true
+	}
+
+	candidates, err := scanLines(os.Stdin)
+	if err != nil {
+		return ioError("", fmt.Errorf("failed to read stdin: %s", err))
+	}
+
+	var added []string
+	for _, line := range candidates {
+		k := key(line)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		// Only removal matters here, other actions would rewrite a line we never had
+		if rule := matcher.Match(k); rule != nil {
+			continue
+		}
+		added = append(added, line)
+	}
+
+	if !quietMode {
+		for _, line := range added {
+			fmt.Println(line)
+		}
+	}
+
+	if !dryRun && len(added) > 0 {
+		if err := writeLinesInPlace(fn, append(existing, added...), defaultWriteBuffer); err != nil {
+			return ioError(fn, fmt.Errorf("failed to write file: %s", err))
+		}
+	}
+	return nil
+}
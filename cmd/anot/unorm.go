@@ -0,0 +1,184 @@
+package main
+
+//go:generate go run gen_unorm.go
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// unicodeForm is a Unicode normalization form, NFC or with compat NFKC:
+// the canonical or compatibility decomposition, canonically ordered and
+// composed again
+type unicodeForm struct {
+	compat bool
+}
+
+// Hangul syllables are decomposed and composed arithmetically
+const (
+	hangulBase  = 0xAC00
+	hangulL     = 0x1100
+	hangulV     = 0x1161
+	hangulT     = 0x11A7
+	hangulLs    = 19
+	hangulVs    = 21
+	hangulTs    = 28
+	hangulCount = hangulLs * hangulVs * hangulTs
+)
+
+// unorm is the normalization data of unorm_tables.go, parsed on first use
+var unorm struct {
+	once    sync.Once
+	ccc     map[rune]uint8
+	canon   map[rune][]rune
+	compat  map[rune][]rune
+	compose map[[2]rune]rune
+}
+
+func loadUnormTables() {
+	unorm.ccc = make(map[rune]uint8)
+	unorm.canon = make(map[rune][]rune)
+	unorm.compat = make(map[rune][]rune)
+	unorm.compose = make(map[[2]rune]rune)
+	for _, line := range strings.Split(strings.TrimSuffix(unormTables, "\n"), "\n") {
+		fields := strings.Fields(line)
+		runes := make([]rune, len(fields)-1)
+		for i, f := range fields[1:] {
+			n, _ := strconv.ParseUint(f, 16, 32)
+			runes[i] = rune(n)
+		}
+		switch fields[0] {
+		case "c":
+			n, _ := strconv.Atoi(fields[2])
+			unorm.ccc[runes[0]] = uint8(n)
+		case "d":
+			unorm.canon[runes[0]] = runes[1:]
+		case "k":
+			unorm.compat[runes[0]] = runes[1:]
+		case "p":
+			unorm.compose[[2]rune{runes[0], runes[1]}] = runes[2]
+		}
+	}
+}
+
+// String returns s in the normalization form. Bytes that aren't UTF-8 are
+// kept as they are, and split s into pieces normalized on their own.
+func (f unicodeForm) String(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		// ASCII is the same in every form
+		return s
+	}
+	unorm.once.Do(loadUnormTables)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for len(s) > 0 {
+		valid := len(s)
+		for i, r := range s {
+			if r == utf8.RuneError {
+				if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+					valid = i
+					break
+				}
+			}
+		}
+		for _, r := range f.compose(f.decompose(s[:valid])) {
+			b.WriteRune(r)
+		}
+		if valid < len(s) {
+			b.WriteByte(s[valid])
+			valid++
+		}
+		s = s[valid:]
+	}
+	return b.String()
+}
+
+// decompose returns the decomposition of s, canonically ordered
+func (f unicodeForm) decompose(s string) []rune {
+	runes := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r >= hangulBase && r < hangulBase+hangulCount {
+			n := r - hangulBase
+			runes = append(runes, hangulL+n/(hangulVs*hangulTs), hangulV+n%(hangulVs*hangulTs)/hangulTs)
+			if t := n % hangulTs; t != 0 {
+				runes = append(runes, hangulT+t)
+			}
+			continue
+		}
+		if d, ok := unorm.compat[r]; ok && f.compat {
+			runes = append(runes, d...)
+		} else if d, ok := unorm.canon[r]; ok {
+			runes = append(runes, d...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+
+	// Runs of combining marks are sorted by class, keeping the order of
+	// those of the same class
+	for i := 1; i < len(runes); i++ {
+		c := unorm.ccc[runes[i]]
+		if c == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			prev := unorm.ccc[runes[j-1]]
+			if prev == 0 || prev <= c {
+				break
+			}
+			runes[j], runes[j-1] = runes[j-1], runes[j]
+		}
+	}
+	return runes
+}
+
+// compose composes the canonically ordered runes in place, each mark with
+// the last starter when nothing between them blocks it
+func (f unicodeForm) compose(runes []rune) []rune {
+	if len(runes) == 0 {
+		return runes
+	}
+	starter := 0
+	last := int(unorm.ccc[runes[0]])
+	if last != 0 {
+		// Nothing composes with a leading mark
+		last = 256
+	}
+	n := 1
+	for _, r := range runes[1:] {
+		c := int(unorm.ccc[r])
+		if composite, ok := composePair(runes[starter], r); ok && (last < c || last == 0) {
+			runes[starter] = composite
+			continue
+		}
+		if c == 0 {
+			starter = n
+		}
+		last = c
+		runes[n] = r
+		n++
+	}
+	return runes[:n]
+}
+
+// composePair returns the canonical composite of two runes, if any
+func composePair(a, b rune) (rune, bool) {
+	if a >= hangulL && a < hangulL+hangulLs && b >= hangulV && b < hangulV+hangulVs {
+		return hangulBase + ((a-hangulL)*hangulVs+b-hangulV)*hangulTs, true
+	}
+	if a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTs == 0 && b > hangulT && b < hangulT+hangulTs {
+		return a + b - hangulT, true
+	}
+	r, ok := unorm.compose[[2]rune{a, b}]
+	return r, ok
+}
@@ -0,0 +1,7748 @@
+// Code generated by go run gen_unorm.go; DO NOT EDIT.
+
+package main
+
+// unicodeNormVersion is the version of Unicode the tables are of
+const unicodeNormVersion = "15.0.0"
+
+// unormTables is parsed by loadUnormTables, one record per line:
+// "c RUNE CLASS" the canonical combining class, "d RUNE RUNE..." the
+// canonical and "k RUNE RUNE..." the compatibility decomposition, and
+// "p FIRST SECOND COMPOSITE" a canonical composition, all in hex
+const unormTables = `c 300 230
+c 301 230
+c 302 230
+c 303 230
+c 304 230
+c 305 230
+c 306 230
+c 307 230
+c 308 230
+c 309 230
+c 30A 230
+c 30B 230
+c 30C 230
+c 30D 230
+c 30E 230
+c 30F 230
+c 310 230
+c 311 230
+c 312 230
+c 313 230
+c 314 230
+c 315 232
+c 316 220
+c 317 220
+c 318 220
+c 319 220
+c 31A 232
+c 31B 216
+c 31C 220
+c 31D 220
+c 31E 220
+c 31F 220
+c 320 220
+c 321 202
+c 322 202
+c 323 220
+c 324 220
+c 325 220
+c 326 220
+c 327 202
+c 328 202
+c 329 220
+c 32A 220
+c 32B 220
+c 32C 220
+c 32D 220
+c 32E 220
+c 32F 220
+c 330 220
+c 331 220
+c 332 220
+c 333 220
+c 334 1
+c 335 1
+c 336 1
+c 337 1
+c 338 1
+c 339 220
+c 33A 220
+c 33B 220
+c 33C 220
+c 33D 230
+c 33E 230
+c 33F 230
+c 340 230
+c 341 230
+c 342 230
+c 343 230
+c 344 230
+c 345 240
+c 346 230
+c 347 220
+c 348 220
+c 349 220
+c 34A 230
+c 34B 230
+c 34C 230
+c 34D 220
+c 34E 220
+c 350 230
+c 351 230
+c 352 230
+c 353 220
+c 354 220
+c 355 220
+c 356 220
+c 357 230
+c 358 232
+c 359 220
+c 35A 220
+c 35B 230
+c 35C 233
+c 35D 234
+c 35E 234
+c 35F 233
+c 360 234
+c 361 234
+c 362 233
+c 363 230
+c 364 230
+c 365 230
+c 366 230
+c 367 230
+c 368 230
+c 369 230
+c 36A 230
+c 36B 230
+c 36C 230
+c 36D 230
+c 36E 230
+c 36F 230
+c 483 230
+c 484 230
+c 485 230
+c 486 230
+c 487 230
+c 591 220
+c 592 230
+c 593 230
+c 594 230
+c 595 230
+c 596 220
+c 597 230
+c 598 230
+c 599 230
+c 59A 222
+c 59B 220
+c 59C 230
+c 59D 230
+c 59E 230
+c 59F 230
+c 5A0 230
+c 5A1 230
+c 5A2 220
+c 5A3 220
+c 5A4 220
+c 5A5 220
+c 5A6 220
+c 5A7 220
+c 5A8 230
+c 5A9 230
+c 5AA 220
+c 5AB 230
+c 5AC 230
+c 5AD 222
+c 5AE 228
+c 5AF 230
+c 5B0 10
+c 5B1 11
+c 5B2 12
+c 5B3 13
+c 5B4 14
+c 5B5 15
+c 5B6 16
+c 5B7 17
+c 5B8 18
+c 5B9 19
+c 5BA 19
+c 5BB 20
+c 5BC 21
+c 5BD 22
+c 5BF 23
+c 5C1 24
+c 5C2 25
+c 5C4 230
+c 5C5 220
+c 5C7 18
+c 610 230
+c 611 230
+c 612 230
+c 613 230
+c 614 230
+c 615 230
+c 616 230
+c 617 230
+c 618 30
+c 619 31
+c 61A 32
+c 64B 27
+c 64C 28
+c 64D 29
+c 64E 30
+c 64F 31
+c 650 32
+c 651 33
+c 652 34
+c 653 230
+c 654 230
+c 655 220
+c 656 220
+c 657 230
+c 658 230
+c 659 230
+c 65A 230
+c 65B 230
+c 65C 220
+c 65D 230
+c 65E 230
+c 65F 220
+c 670 35
+c 6D6 230
+c 6D7 230
+c 6D8 230
+c 6D9 230
+c 6DA 230
+c 6DB 230
+c 6DC 230
+c 6DF 230
+c 6E0 230
+c 6E1 230
+c 6E2 230
+c 6E3 220
+c 6E4 230
+c 6E7 230
+c 6E8 230
+c 6EA 220
+c 6EB 230
+c 6EC 230
+c 6ED 220
+c 711 36
+c 730 230
+c 731 220
+c 732 230
+c 733 230
+c 734 220
+c 735 230
+c 736 230
+c 737 220
+c 738 220
+c 739 220
+c 73A 230
+c 73B 220
+c 73C 220
+c 73D 230
+c 73E 220
+c 73F 230
+c 740 230
+c 741 230
+c 742 220
+c 743 230
+c 744 220
+c 745 230
+c 746 220
+c 747 230
+c 748 220
+c 749 230
+c 74A 230
+c 7EB 230
+c 7EC 230
+c 7ED 230
+c 7EE 230
+c 7EF 230
+c 7F0 230
+c 7F1 230
+c 7F2 220
+c 7F3 230
+c 7FD 220
+c 816 230
+c 817 230
+c 818 230
+c 819 230
+c 81B 230
+c 81C 230
+c 81D 230
+c 81E 230
+c 81F 230
+c 820 230
+c 821 230
+c 822 230
+c 823 230
+c 825 230
+c 826 230
+c 827 230
+c 829 230
+c 82A 230
+c 82B 230
+c 82C 230
+c 82D 230
+c 859 220
+c 85A 220
+c 85B 220
+c 898 230
+c 899 220
+c 89A 220
+c 89B 220
+c 89C 230
+c 89D 230
+c 89E 230
+c 89F 230
+c 8CA 230
+c 8CB 230
+c 8CC 230
+c 8CD 230
+c 8CE 230
+c 8CF 220
+c 8D0 220
+c 8D1 220
+c 8D2 220
+c 8D3 220
+c 8D4 230
+c 8D5 230
+c 8D6 230
+c 8D7 230
+c 8D8 230
+c 8D9 230
+c 8DA 230
+c 8DB 230
+c 8DC 230
+c 8DD 230
+c 8DE 230
+c 8DF 230
+c 8E0 230
+c 8E1 230
+c 8E3 220
+c 8E4 230
+c 8E5 230
+c 8E6 220
+c 8E7 230
+c 8E8 230
+c 8E9 220
+c 8EA 230
+c 8EB 230
+c 8EC 230
+c 8ED 220
+c 8EE 220
+c 8EF 220
+c 8F0 27
+c 8F1 28
+c 8F2 29
+c 8F3 230
+c 8F4 230
+c 8F5 230
+c 8F6 220
+c 8F7 230
+c 8F8 230
+c 8F9 220
+c 8FA 220
+c 8FB 230
+c 8FC 230
+c 8FD 230
+c 8FE 230
+c 8FF 230
+c 93C 7
+c 94D 9
+c 951 230
+c 952 220
+c 953 230
+c 954 230
+c 9BC 7
+c 9CD 9
+c 9FE 230
+c A3C 7
+c A4D 9
+c ABC 7
+c ACD 9
+c B3C 7
+c B4D 9
+c BCD 9
+c C3C 7
+c C4D 9
+c C55 84
+c C56 91
+c CBC 7
+c CCD 9
+c D3B 9
+c D3C 9
+c D4D 9
+c DCA 9
+c E38 103
+c E39 103
+c E3A 9
+c E48 107
+c E49 107
+c E4A 107
+c E4B 107
+c EB8 118
+c EB9 118
+c EBA 9
+c EC8 122
+c EC9 122
+c ECA 122
+c ECB 122
+c F18 220
+c F19 220
+c F35 220
+c F37 220
+c F39 216
+c F71 129
+c F72 130
+c F74 132
+c F7A 130
+c F7B 130
+c F7C 130
+c F7D 130
+c F80 130
+c F82 230
+c F83 230
+c F84 9
+c F86 230
+c F87 230
+c FC6 220
+c 1037 7
+c 1039 9
+c 103A 9
+c 108D 220
+c 135D 230
+c 135E 230
+c 135F 230
+c 1714 9
+c 1715 9
+c 1734 9
+c 17D2 9
+c 17DD 230
+c 18A9 228
+c 1939 222
+c 193A 230
+c 193B 220
+c 1A17 230
+c 1A18 220
+c 1A60 9
+c 1A75 230
+c 1A76 230
+c 1A77 230
+c 1A78 230
+c 1A79 230
+c 1A7A 230
+c 1A7B 230
+c 1A7C 230
+c 1A7F 220
+c 1AB0 230
+c 1AB1 230
+c 1AB2 230
+c 1AB3 230
+c 1AB4 230
+c 1AB5 220
+c 1AB6 220
+c 1AB7 220
+c 1AB8 220
+c 1AB9 220
+c 1ABA 220
+c 1ABB 230
+c 1ABC 230
+c 1ABD 220
+c 1ABF 220
+c 1AC0 220
+c 1AC1 230
+c 1AC2 230
+c 1AC3 220
+c 1AC4 220
+c 1AC5 230
+c 1AC6 230
+c 1AC7 230
+c 1AC8 230
+c 1AC9 230
+c 1ACA 220
+c 1ACB 230
+c 1ACC 230
+c 1ACD 230
+c 1ACE 230
+c 1B34 7
+c 1B44 9
+c 1B6B 230
+c 1B6C 220
+c 1B6D 230
+c 1B6E 230
+c 1B6F 230
+c 1B70 230
+c 1B71 230
+c 1B72 230
+c 1B73 230
+c 1BAA 9
+c 1BAB 9
+c 1BE6 7
+c 1BF2 9
+c 1BF3 9
+c 1C37 7
+c 1CD0 230
+c 1CD1 230
+c 1CD2 230
+c 1CD4 1
+c 1CD5 220
+c 1CD6 220
+c 1CD7 220
+c 1CD8 220
+c 1CD9 220
+c 1CDA 230
+c 1CDB 230
+c 1CDC 220
+c 1CDD 220
+c 1CDE 220
+c 1CDF 220
+c 1CE0 230
+c 1CE2 1
+c 1CE3 1
+c 1CE4 1
+c 1CE5 1
+c 1CE6 1
+c 1CE7 1
+c 1CE8 1
+c 1CED 220
+c 1CF4 230
+c 1CF8 230
+c 1CF9 230
+c 1DC0 230
+c 1DC1 230
+c 1DC2 220
+c 1DC3 230
+c 1DC4 230
+c 1DC5 230
+c 1DC6 230
+c 1DC7 230
+c 1DC8 230
+c 1DC9 230
+c 1DCA 220
+c 1DCB 230
+c 1DCC 230
+c 1DCD 234
+c 1DCE 214
+c 1DCF 220
+c 1DD0 202
+c 1DD1 230
+c 1DD2 230
+c 1DD3 230
+c 1DD4 230
+c 1DD5 230
+c 1DD6 230
+c 1DD7 230
+c 1DD8 230
+c 1DD9 230
+c 1DDA 230
+c 1DDB 230
+c 1DDC 230
+c 1DDD 230
+c 1DDE 230
+c 1DDF 230
+c 1DE0 230
+c 1DE1 230
+c 1DE2 230
+c 1DE3 230
+c 1DE4 230
+c 1DE5 230
+c 1DE6 230
+c 1DE7 230
+c 1DE8 230
+c 1DE9 230
+c 1DEA 230
+c 1DEB 230
+c 1DEC 230
+c 1DED 230
+c 1DEE 230
+c 1DEF 230
+c 1DF0 230
+c 1DF1 230
+c 1DF2 230
+c 1DF3 230
+c 1DF4 230
+c 1DF5 230
+c 1DF6 232
+c 1DF7 228
+c 1DF8 228
+c 1DF9 220
+c 1DFA 218
+c 1DFB 230
+c 1DFC 233
+c 1DFD 220
+c 1DFE 230
+c 1DFF 220
+c 20D0 230
+c 20D1 230
+c 20D2 1
+c 20D3 1
+c 20D4 230
+c 20D5 230
+c 20D6 230
+c 20D7 230
+c 20D8 1
+c 20D9 1
+c 20DA 1
+c 20DB 230
+c 20DC 230
+c 20E1 230
+c 20E5 1
+c 20E6 1
+c 20E7 230
+c 20E8 220
+c 20E9 230
+c 20EA 1
+c 20EB 1
+c 20EC 220
+c 20ED 220
+c 20EE 220
+c 20EF 220
+c 20F0 230
+c 2CEF 230
+c 2CF0 230
+c 2CF1 230
+c 2D7F 9
+c 2DE0 230
+c 2DE1 230
+c 2DE2 230
+c 2DE3 230
+c 2DE4 230
+c 2DE5 230
+c 2DE6 230
+c 2DE7 230
+c 2DE8 230
+c 2DE9 230
+c 2DEA 230
+c 2DEB 230
+c 2DEC 230
+c 2DED 230
+c 2DEE 230
+c 2DEF 230
+c 2DF0 230
+c 2DF1 230
+c 2DF2 230
+c 2DF3 230
+c 2DF4 230
+c 2DF5 230
+c 2DF6 230
+c 2DF7 230
+c 2DF8 230
+c 2DF9 230
+c 2DFA 230
+c 2DFB 230
+c 2DFC 230
+c 2DFD 230
+c 2DFE 230
+c 2DFF 230
+c 302A 218
+c 302B 228
+c 302C 232
+c 302D 222
+c 302E 224
+c 302F 224
+c 3099 8
+c 309A 8
+c A66F 230
+c A674 230
+c A675 230
+c A676 230
+c A677 230
+c A678 230
+c A679 230
+c A67A 230
+c A67B 230
+c A67C 230
+c A67D 230
+c A69E 230
+c A69F 230
+c A6F0 230
+c A6F1 230
+c A806 9
+c A82C 9
+c A8C4 9
+c A8E0 230
+c A8E1 230
+c A8E2 230
+c A8E3 230
+c A8E4 230
+c A8E5 230
+c A8E6 230
+c A8E7 230
+c A8E8 230
+c A8E9 230
+c A8EA 230
+c A8EB 230
+c A8EC 230
+c A8ED 230
+c A8EE 230
+c A8EF 230
+c A8F0 230
+c A8F1 230
+c A92B 220
+c A92C 220
+c A92D 220
+c A953 9
+c A9B3 7
+c A9C0 9
+c AAB0 230
+c AAB2 230
+c AAB3 230
+c AAB4 220
+c AAB7 230
+c AAB8 230
+c AABE 230
+c AABF 230
+c AAC1 230
+c AAF6 9
+c ABED 9
+c FB1E 26
+c FE20 230
+c FE21 230
+c FE22 230
+c FE23 230
+c FE24 230
+c FE25 230
+c FE26 230
+c FE27 220
+c FE28 220
+c FE29 220
+c FE2A 220
+c FE2B 220
+c FE2C 220
+c FE2D 220
+c FE2E 230
+c FE2F 230
+c 101FD 220
+c 102E0 220
+c 10376 230
+c 10377 230
+c 10378 230
+c 10379 230
+c 1037A 230
+c 10A0D 220
+c 10A0F 230
+c 10A38 230
+c 10A39 1
+c 10A3A 220
+c 10A3F 9
+c 10AE5 230
+c 10AE6 220
+c 10D24 230
+c 10D25 230
+c 10D26 230
+c 10D27 230
+c 10EAB 230
+c 10EAC 230
+c 10EFD 220
+c 10EFE 220
+c 10EFF 220
+c 10F46 220
+c 10F47 220
+c 10F48 230
+c 10F49 230
+c 10F4A 230
+c 10F4B 220
+c 10F4C 230
+c 10F4D 220
+c 10F4E 220
+c 10F4F 220
+c 10F50 220
+c 10F82 230
+c 10F83 220
+c 10F84 230
+c 10F85 220
+c 11046 9
+c 11070 9
+c 1107F 9
+c 110B9 9
+c 110BA 7
+c 11100 230
+c 11101 230
+c 11102 230
+c 11133 9
+c 11134 9
+c 11173 7
+c 111C0 9
+c 111CA 7
+c 11235 9
+c 11236 7
+c 112E9 7
+c 112EA 9
+c 1133B 7
+c 1133C 7
+c 1134D 9
+c 11366 230
+c 11367 230
+c 11368 230
+c 11369 230
+c 1136A 230
+c 1136B 230
+c 1136C 230
+c 11370 230
+c 11371 230
+c 11372 230
+c 11373 230
+c 11374 230
+c 11442 9
+c 11446 7
+c 1145E 230
+c 114C2 9
+c 114C3 7
+c 115BF 9
+c 115C0 7
+c 1163F 9
+c 116B6 9
+c 116B7 7
+c 1172B 9
+c 11839 9
+c 1183A 7
+c 1193D 9
+c 1193E 9
+c 11943 7
+c 119E0 9
+c 11A34 9
+c 11A47 9
+c 11A99 9
+c 11C3F 9
+c 11D42 7
+c 11D44 9
+c 11D45 9
+c 11D97 9
+c 11F41 9
+c 11F42 9
+c 16AF0 1
+c 16AF1 1
+c 16AF2 1
+c 16AF3 1
+c 16AF4 1
+c 16B30 230
+c 16B31 230
+c 16B32 230
+c 16B33 230
+c 16B34 230
+c 16B35 230
+c 16B36 230
+c 16FF0 6
+c 16FF1 6
+c 1BC9E 1
+c 1D165 216
+c 1D166 216
+c 1D167 1
+c 1D168 1
+c 1D169 1
+c 1D16D 226
+c 1D16E 216
+c 1D16F 216
+c 1D170 216
+c 1D171 216
+c 1D172 216
+c 1D17B 220
+c 1D17C 220
+c 1D17D 220
+c 1D17E 220
+c 1D17F 220
+c 1D180 220
+c 1D181 220
+c 1D182 220
+c 1D185 230
+c 1D186 230
+c 1D187 230
+c 1D188 230
+c 1D189 230
+c 1D18A 220
+c 1D18B 220
+c 1D1AA 230
+c 1D1AB 230
+c 1D1AC 230
+c 1D1AD 230
+c 1D242 230
+c 1D243 230
+c 1D244 230
+c 1E000 230
+c 1E001 230
+c 1E002 230
+c 1E003 230
+c 1E004 230
+c 1E005 230
+c 1E006 230
+c 1E008 230
+c 1E009 230
+c 1E00A 230
+c 1E00B 230
+c 1E00C 230
+c 1E00D 230
+c 1E00E 230
+c 1E00F 230
+c 1E010 230
+c 1E011 230
+c 1E012 230
+c 1E013 230
+c 1E014 230
+c 1E015 230
+c 1E016 230
+c 1E017 230
+c 1E018 230
+c 1E01B 230
+c 1E01C 230
+c 1E01D 230
+c 1E01E 230
+c 1E01F 230
+c 1E020 230
+c 1E021 230
+c 1E023 230
+c 1E024 230
+c 1E026 230
+c 1E027 230
+c 1E028 230
+c 1E029 230
+c 1E02A 230
+c 1E08F 230
+c 1E130 230
+c 1E131 230
+c 1E132 230
+c 1E133 230
+c 1E134 230
+c 1E135 230
+c 1E136 230
+c 1E2AE 230
+c 1E2EC 230
+c 1E2ED 230
+c 1E2EE 230
+c 1E2EF 230
+c 1E4EC 232
+c 1E4ED 232
+c 1E4EE 220
+c 1E4EF 230
+c 1E8D0 220
+c 1E8D1 220
+c 1E8D2 220
+c 1E8D3 220
+c 1E8D4 220
+c 1E8D5 220
+c 1E8D6 220
+c 1E944 230
+c 1E945 230
+c 1E946 230
+c 1E947 230
+c 1E948 230
+c 1E949 230
+c 1E94A 7
+d C0 41 300
+d C1 41 301
+d C2 41 302
+d C3 41 303
+d C4 41 308
+d C5 41 30A
+d C7 43 327
+d C8 45 300
+d C9 45 301
+d CA 45 302
+d CB 45 308
+d CC 49 300
+d CD 49 301
+d CE 49 302
+d CF 49 308
+d D1 4E 303
+d D2 4F 300
+d D3 4F 301
+d D4 4F 302
+d D5 4F 303
+d D6 4F 308
+d D9 55 300
+d DA 55 301
+d DB 55 302
+d DC 55 308
+d DD 59 301
+d E0 61 300
+d E1 61 301
+d E2 61 302
+d E3 61 303
+d E4 61 308
+d E5 61 30A
+d E7 63 327
+d E8 65 300
+d E9 65 301
+d EA 65 302
+d EB 65 308
+d EC 69 300
+d ED 69 301
+d EE 69 302
+d EF 69 308
+d F1 6E 303
+d F2 6F 300
+d F3 6F 301
+d F4 6F 302
+d F5 6F 303
+d F6 6F 308
+d F9 75 300
+d FA 75 301
+d FB 75 302
+d FC 75 308
+d FD 79 301
+d FF 79 308
+d 100 41 304
+d 101 61 304
+d 102 41 306
+d 103 61 306
+d 104 41 328
+d 105 61 328
+d 106 43 301
+d 107 63 301
+d 108 43 302
+d 109 63 302
+d 10A 43 307
+d 10B 63 307
+d 10C 43 30C
+d 10D 63 30C
+d 10E 44 30C
+d 10F 64 30C
+d 112 45 304
+d 113 65 304
+d 114 45 306
+d 115 65 306
+d 116 45 307
+d 117 65 307
+d 118 45 328
+d 119 65 328
+d 11A 45 30C
+d 11B 65 30C
+d 11C 47 302
+d 11D 67 302
+d 11E 47 306
+d 11F 67 306
+d 120 47 307
+d 121 67 307
+d 122 47 327
+d 123 67 327
+d 124 48 302
+d 125 68 302
+d 128 49 303
+d 129 69 303
+d 12A 49 304
+d 12B 69 304
+d 12C 49 306
+d 12D 69 306
+d 12E 49 328
+d 12F 69 328
+d 130 49 307
+d 134 4A 302
+d 135 6A 302
+d 136 4B 327
+d 137 6B 327
+d 139 4C 301
+d 13A 6C 301
+d 13B 4C 327
+d 13C 6C 327
+d 13D 4C 30C
+d 13E 6C 30C
+d 143 4E 301
+d 144 6E 301
+d 145 4E 327
+d 146 6E 327
+d 147 4E 30C
+d 148 6E 30C
+d 14C 4F 304
+d 14D 6F 304
+d 14E 4F 306
+d 14F 6F 306
+d 150 4F 30B
+d 151 6F 30B
+d 154 52 301
+d 155 72 301
+d 156 52 327
+d 157 72 327
+d 158 52 30C
+d 159 72 30C
+d 15A 53 301
+d 15B 73 301
+d 15C 53 302
+d 15D 73 302
+d 15E 53 327
+d 15F 73 327
+d 160 53 30C
+d 161 73 30C
+d 162 54 327
+d 163 74 327
+d 164 54 30C
+d 165 74 30C
+d 168 55 303
+d 169 75 303
+d 16A 55 304
+d 16B 75 304
+d 16C 55 306
+d 16D 75 306
+d 16E 55 30A
+d 16F 75 30A
+d 170 55 30B
+d 171 75 30B
+d 172 55 328
+d 173 75 328
+d 174 57 302
+d 175 77 302
+d 176 59 302
+d 177 79 302
+d 178 59 308
+d 179 5A 301
+d 17A 7A 301
+d 17B 5A 307
+d 17C 7A 307
+d 17D 5A 30C
+d 17E 7A 30C
+d 1A0 4F 31B
+d 1A1 6F 31B
+d 1AF 55 31B
+d 1B0 75 31B
+d 1CD 41 30C
+d 1CE 61 30C
+d 1CF 49 30C
+d 1D0 69 30C
+d 1D1 4F 30C
+d 1D2 6F 30C
+d 1D3 55 30C
+d 1D4 75 30C
+d 1D5 55 308 304
+d 1D6 75 308 304
+d 1D7 55 308 301
+d 1D8 75 308 301
+d 1D9 55 308 30C
+d 1DA 75 308 30C
+d 1DB 55 308 300
+d 1DC 75 308 300
+d 1DE 41 308 304
+d 1DF 61 308 304
+d 1E0 41 307 304
+d 1E1 61 307 304
+d 1E2 C6 304
+d 1E3 E6 304
+d 1E6 47 30C
+d 1E7 67 30C
+d 1E8 4B 30C
+d 1E9 6B 30C
+d 1EA 4F 328
+d 1EB 6F 328
+d 1EC 4F 328 304
+d 1ED 6F 328 304
+d 1EE 1B7 30C
+d 1EF 292 30C
+d 1F0 6A 30C
+d 1F4 47 301
+d 1F5 67 301
+d 1F8 4E 300
+d 1F9 6E 300
+d 1FA 41 30A 301
+d 1FB 61 30A 301
+d 1FC C6 301
+d 1FD E6 301
+d 1FE D8 301
+d 1FF F8 301
+d 200 41 30F
+d 201 61 30F
+d 202 41 311
+d 203 61 311
+d 204 45 30F
+d 205 65 30F
+d 206 45 311
+d 207 65 311
+d 208 49 30F
+d 209 69 30F
+d 20A 49 311
+d 20B 69 311
+d 20C 4F 30F
+d 20D 6F 30F
+d 20E 4F 311
+d 20F 6F 311
+d 210 52 30F
+d 211 72 30F
+d 212 52 311
+d 213 72 311
+d 214 55 30F
+d 215 75 30F
+d 216 55 311
+d 217 75 311
+d 218 53 326
+d 219 73 326
+d 21A 54 326
+d 21B 74 326
+d 21E 48 30C
+d 21F 68 30C
+d 226 41 307
+d 227 61 307
+d 228 45 327
+d 229 65 327
+d 22A 4F 308 304
+d 22B 6F 308 304
+d 22C 4F 303 304
+d 22D 6F 303 304
+d 22E 4F 307
+d 22F 6F 307
+d 230 4F 307 304
+d 231 6F 307 304
+d 232 59 304
+d 233 79 304
+d 340 300
+d 341 301
+d 343 313
+d 344 308 301
+d 374 2B9
+d 37E 3B
+d 385 A8 301
+d 386 391 301
+d 387 B7
+d 388 395 301
+d 389 397 301
+d 38A 399 301
+d 38C 39F 301
+d 38E 3A5 301
+d 38F 3A9 301
+d 390 3B9 308 301
+d 3AA 399 308
+d 3AB 3A5 308
+d 3AC 3B1 301
+d 3AD 3B5 301
+d 3AE 3B7 301
+d 3AF 3B9 301
+d 3B0 3C5 308 301
+d 3CA 3B9 308
+d 3CB 3C5 308
+d 3CC 3BF 301
+d 3CD 3C5 301
+d 3CE 3C9 301
+d 3D3 3D2 301
+d 3D4 3D2 308
+d 400 415 300
+d 401 415 308
+d 403 413 301
+d 407 406 308
+d 40C 41A 301
+d 40D 418 300
+d 40E 423 306
+d 419 418 306
+d 439 438 306
+d 450 435 300
+d 451 435 308
+d 453 433 301
+d 457 456 308
+d 45C 43A 301
+d 45D 438 300
+d 45E 443 306
+d 476 474 30F
+d 477 475 30F
+d 4C1 416 306
+d 4C2 436 306
+d 4D0 410 306
+d 4D1 430 306
+d 4D2 410 308
+d 4D3 430 308
+d 4D6 415 306
+d 4D7 435 306
+d 4DA 4D8 308
+d 4DB 4D9 308
+d 4DC 416 308
+d 4DD 436 308
+d 4DE 417 308
+d 4DF 437 308
+d 4E2 418 304
+d 4E3 438 304
+d 4E4 418 308
+d 4E5 438 308
+d 4E6 41E 308
+d 4E7 43E 308
+d 4EA 4E8 308
+d 4EB 4E9 308
+d 4EC 42D 308
+d 4ED 44D 308
+d 4EE 423 304
+d 4EF 443 304
+d 4F0 423 308
+d 4F1 443 308
+d 4F2 423 30B
+d 4F3 443 30B
+d 4F4 427 308
+d 4F5 447 308
+d 4F8 42B 308
+d 4F9 44B 308
+d 622 627 653
+d 623 627 654
+d 624 648 654
+d 625 627 655
+d 626 64A 654
+d 6C0 6D5 654
+d 6C2 6C1 654
+d 6D3 6D2 654
+d 929 928 93C
+d 931 930 93C
+d 934 933 93C
+d 958 915 93C
+d 959 916 93C
+d 95A 917 93C
+d 95B 91C 93C
+d 95C 921 93C
+d 95D 922 93C
+d 95E 92B 93C
+d 95F 92F 93C
+d 9CB 9C7 9BE
+d 9CC 9C7 9D7
+d 9DC 9A1 9BC
+d 9DD 9A2 9BC
+d 9DF 9AF 9BC
+d A33 A32 A3C
+d A36 A38 A3C
+d A59 A16 A3C
+d A5A A17 A3C
+d A5B A1C A3C
+d A5E A2B A3C
+d B48 B47 B56
+d B4B B47 B3E
+d B4C B47 B57
+d B5C B21 B3C
+d B5D B22 B3C
+d B94 B92 BD7
+d BCA BC6 BBE
+d BCB BC7 BBE
+d BCC BC6 BD7
+d C48 C46 C56
+d CC0 CBF CD5
+d CC7 CC6 CD5
+d CC8 CC6 CD6
+d CCA CC6 CC2
+d CCB CC6 CC2 CD5
+d D4A D46 D3E
+d D4B D47 D3E
+d D4C D46 D57
+d DDA DD9 DCA
+d DDC DD9 DCF
+d DDD DD9 DCF DCA
+d DDE DD9 DDF
+d F43 F42 FB7
+d F4D F4C FB7
+d F52 F51 FB7
+d F57 F56 FB7
+d F5C F5B FB7
+d F69 F40 FB5
+d F73 F71 F72
+d F75 F71 F74
+d F76 FB2 F80
+d F78 FB3 F80
+d F81 F71 F80
+d F93 F92 FB7
+d F9D F9C FB7
+d FA2 FA1 FB7
+d FA7 FA6 FB7
+d FAC FAB FB7
+d FB9 F90 FB5
+d 1026 1025 102E
+d 1B06 1B05 1B35
+d 1B08 1B07 1B35
+d 1B0A 1B09 1B35
+d 1B0C 1B0B 1B35
+d 1B0E 1B0D 1B35
+d 1B12 1B11 1B35
+d 1B3B 1B3A 1B35
+d 1B3D 1B3C 1B35
+d 1B40 1B3E 1B35
+d 1B41 1B3F 1B35
+d 1B43 1B42 1B35
+d 1E00 41 325
+d 1E01 61 325
+d 1E02 42 307
+d 1E03 62 307
+d 1E04 42 323
+d 1E05 62 323
+d 1E06 42 331
+d 1E07 62 331
+d 1E08 43 327 301
+d 1E09 63 327 301
+d 1E0A 44 307
+d 1E0B 64 307
+d 1E0C 44 323
+d 1E0D 64 323
+d 1E0E 44 331
+d 1E0F 64 331
+d 1E10 44 327
+d 1E11 64 327
+d 1E12 44 32D
+d 1E13 64 32D
+d 1E14 45 304 300
+d 1E15 65 304 300
+d 1E16 45 304 301
+d 1E17 65 304 301
+d 1E18 45 32D
+d 1E19 65 32D
+d 1E1A 45 330
+d 1E1B 65 330
+d 1E1C 45 327 306
+d 1E1D 65 327 306
+d 1E1E 46 307
+d 1E1F 66 307
+d 1E20 47 304
+d 1E21 67 304
+d 1E22 48 307
+d 1E23 68 307
+d 1E24 48 323
+d 1E25 68 323
+d 1E26 48 308
+d 1E27 68 308
+d 1E28 48 327
+d 1E29 68 327
+d 1E2A 48 32E
+d 1E2B 68 32E
+d 1E2C 49 330
+d 1E2D 69 330
+d 1E2E 49 308 301
+d 1E2F 69 308 301
+d 1E30 4B 301
+d 1E31 6B 301
+d 1E32 4B 323
+d 1E33 6B 323
+d 1E34 4B 331
+d 1E35 6B 331
+d 1E36 4C 323
+d 1E37 6C 323
+d 1E38 4C 323 304
+d 1E39 6C 323 304
+d 1E3A 4C 331
+d 1E3B 6C 331
+d 1E3C 4C 32D
+d 1E3D 6C 32D
+d 1E3E 4D 301
+d 1E3F 6D 301
+d 1E40 4D 307
+d 1E41 6D 307
+d 1E42 4D 323
+d 1E43 6D 323
+d 1E44 4E 307
+d 1E45 6E 307
+d 1E46 4E 323
+d 1E47 6E 323
+d 1E48 4E 331
+d 1E49 6E 331
+d 1E4A 4E 32D
+d 1E4B 6E 32D
+d 1E4C 4F 303 301
+d 1E4D 6F 303 301
+d 1E4E 4F 303 308
+d 1E4F 6F 303 308
+d 1E50 4F 304 300
+d 1E51 6F 304 300
+d 1E52 4F 304 301
+d 1E53 6F 304 301
+d 1E54 50 301
+d 1E55 70 301
+d 1E56 50 307
+d 1E57 70 307
+d 1E58 52 307
+d 1E59 72 307
+d 1E5A 52 323
+d 1E5B 72 323
+d 1E5C 52 323 304
+d 1E5D 72 323 304
+d 1E5E 52 331
+d 1E5F 72 331
+d 1E60 53 307
+d 1E61 73 307
+d 1E62 53 323
+d 1E63 73 323
+d 1E64 53 301 307
+d 1E65 73 301 307
+d 1E66 53 30C 307
+d 1E67 73 30C 307
+d 1E68 53 323 307
+d 1E69 73 323 307
+d 1E6A 54 307
+d 1E6B 74 307
+d 1E6C 54 323
+d 1E6D 74 323
+d 1E6E 54 331
+d 1E6F 74 331
+d 1E70 54 32D
+d 1E71 74 32D
+d 1E72 55 324
+d 1E73 75 324
+d 1E74 55 330
+d 1E75 75 330
+d 1E76 55 32D
+d 1E77 75 32D
+d 1E78 55 303 301
+d 1E79 75 303 301
+d 1E7A 55 304 308
+d 1E7B 75 304 308
+d 1E7C 56 303
+d 1E7D 76 303
+d 1E7E 56 323
+d 1E7F 76 323
+d 1E80 57 300
+d 1E81 77 300
+d 1E82 57 301
+d 1E83 77 301
+d 1E84 57 308
+d 1E85 77 308
+d 1E86 57 307
+d 1E87 77 307
+d 1E88 57 323
+d 1E89 77 323
+d 1E8A 58 307
+d 1E8B 78 307
+d 1E8C 58 308
+d 1E8D 78 308
+d 1E8E 59 307
+d 1E8F 79 307
+d 1E90 5A 302
+d 1E91 7A 302
+d 1E92 5A 323
+d 1E93 7A 323
+d 1E94 5A 331
+d 1E95 7A 331
+d 1E96 68 331
+d 1E97 74 308
+d 1E98 77 30A
+d 1E99 79 30A
+d 1E9B 17F 307
+d 1EA0 41 323
+d 1EA1 61 323
+d 1EA2 41 309
+d 1EA3 61 309
+d 1EA4 41 302 301
+d 1EA5 61 302 301
+d 1EA6 41 302 300
+d 1EA7 61 302 300
+d 1EA8 41 302 309
+d 1EA9 61 302 309
+d 1EAA 41 302 303
+d 1EAB 61 302 303
+d 1EAC 41 323 302
+d 1EAD 61 323 302
+d 1EAE 41 306 301
+d 1EAF 61 306 301
+d 1EB0 41 306 300
+d 1EB1 61 306 300
+d 1EB2 41 306 309
+d 1EB3 61 306 309
+d 1EB4 41 306 303
+d 1EB5 61 306 303
+d 1EB6 41 323 306
+d 1EB7 61 323 306
+d 1EB8 45 323
+d 1EB9 65 323
+d 1EBA 45 309
+d 1EBB 65 309
+d 1EBC 45 303
+d 1EBD 65 303
+d 1EBE 45 302 301
+d 1EBF 65 302 301
+d 1EC0 45 302 300
+d 1EC1 65 302 300
+d 1EC2 45 302 309
+d 1EC3 65 302 309
+d 1EC4 45 302 303
+d 1EC5 65 302 303
+d 1EC6 45 323 302
+d 1EC7 65 323 302
+d 1EC8 49 309
+d 1EC9 69 309
+d 1ECA 49 323
+d 1ECB 69 323
+d 1ECC 4F 323
+d 1ECD 6F 323
+d 1ECE 4F 309
+d 1ECF 6F 309
+d 1ED0 4F 302 301
+d 1ED1 6F 302 301
+d 1ED2 4F 302 300
+d 1ED3 6F 302 300
+d 1ED4 4F 302 309
+d 1ED5 6F 302 309
+d 1ED6 4F 302 303
+d 1ED7 6F 302 303
+d 1ED8 4F 323 302
+d 1ED9 6F 323 302
+d 1EDA 4F 31B 301
+d 1EDB 6F 31B 301
+d 1EDC 4F 31B 300
+d 1EDD 6F 31B 300
+d 1EDE 4F 31B 309
+d 1EDF 6F 31B 309
+d 1EE0 4F 31B 303
+d 1EE1 6F 31B 303
+d 1EE2 4F 31B 323
+d 1EE3 6F 31B 323
+d 1EE4 55 323
+d 1EE5 75 323
+d 1EE6 55 309
+d 1EE7 75 309
+d 1EE8 55 31B 301
+d 1EE9 75 31B 301
+d 1EEA 55 31B 300
+d 1EEB 75 31B 300
+d 1EEC 55 31B 309
+d 1EED 75 31B 309
+d 1EEE 55 31B 303
+d 1EEF 75 31B 303
+d 1EF0 55 31B 323
+d 1EF1 75 31B 323
+d 1EF2 59 300
+d 1EF3 79 300
+d 1EF4 59 323
+d 1EF5 79 323
+d 1EF6 59 309
+d 1EF7 79 309
+d 1EF8 59 303
+d 1EF9 79 303
+d 1F00 3B1 313
+d 1F01 3B1 314
+d 1F02 3B1 313 300
+d 1F03 3B1 314 300
+d 1F04 3B1 313 301
+d 1F05 3B1 314 301
+d 1F06 3B1 313 342
+d 1F07 3B1 314 342
+d 1F08 391 313
+d 1F09 391 314
+d 1F0A 391 313 300
+d 1F0B 391 314 300
+d 1F0C 391 313 301
+d 1F0D 391 314 301
+d 1F0E 391 313 342
+d 1F0F 391 314 342
+d 1F10 3B5 313
+d 1F11 3B5 314
+d 1F12 3B5 313 300
+d 1F13 3B5 314 300
+d 1F14 3B5 313 301
+d 1F15 3B5 314 301
+d 1F18 395 313
+d 1F19 395 314
+d 1F1A 395 313 300
+d 1F1B 395 314 300
+d 1F1C 395 313 301
+d 1F1D 395 314 301
+d 1F20 3B7 313
+d 1F21 3B7 314
+d 1F22 3B7 313 300
+d 1F23 3B7 314 300
+d 1F24 3B7 313 301
+d 1F25 3B7 314 301
+d 1F26 3B7 313 342
+d 1F27 3B7 314 342
+d 1F28 397 313
+d 1F29 397 314
+d 1F2A 397 313 300
+d 1F2B 397 314 300
+d 1F2C 397 313 301
+d 1F2D 397 314 301
+d 1F2E 397 313 342
+d 1F2F 397 314 342
+d 1F30 3B9 313
+d 1F31 3B9 314
+d 1F32 3B9 313 300
+d 1F33 3B9 314 300
+d 1F34 3B9 313 301
+d 1F35 3B9 314 301
+d 1F36 3B9 313 342
+d 1F37 3B9 314 342
+d 1F38 399 313
+d 1F39 399 314
+d 1F3A 399 313 300
+d 1F3B 399 314 300
+d 1F3C 399 313 301
+d 1F3D 399 314 301
+d 1F3E 399 313 342
+d 1F3F 399 314 342
+d 1F40 3BF 313
+d 1F41 3BF 314
+d 1F42 3BF 313 300
+d 1F43 3BF 314 300
+d 1F44 3BF 313 301
+d 1F45 3BF 314 301
+d 1F48 39F 313
+d 1F49 39F 314
+d 1F4A 39F 313 300
+d 1F4B 39F 314 300
+d 1F4C 39F 313 301
+d 1F4D 39F 314 301
+d 1F50 3C5 313
+d 1F51 3C5 314
+d 1F52 3C5 313 300
+d 1F53 3C5 314 300
+d 1F54 3C5 313 301
+d 1F55 3C5 314 301
+d 1F56 3C5 313 342
+d 1F57 3C5 314 342
+d 1F59 3A5 314
+d 1F5B 3A5 314 300
+d 1F5D 3A5 314 301
+d 1F5F 3A5 314 342
+d 1F60 3C9 313
+d 1F61 3C9 314
+d 1F62 3C9 313 300
+d 1F63 3C9 314 300
+d 1F64 3C9 313 301
+d 1F65 3C9 314 301
+d 1F66 3C9 313 342
+d 1F67 3C9 314 342
+d 1F68 3A9 313
+d 1F69 3A9 314
+d 1F6A 3A9 313 300
+d 1F6B 3A9 314 300
+d 1F6C 3A9 313 301
+d 1F6D 3A9 314 301
+d 1F6E 3A9 313 342
+d 1F6F 3A9 314 342
+d 1F70 3B1 300
+d 1F71 3B1 301
+d 1F72 3B5 300
+d 1F73 3B5 301
+d 1F74 3B7 300
+d 1F75 3B7 301
+d 1F76 3B9 300
+d 1F77 3B9 301
+d 1F78 3BF 300
+d 1F79 3BF 301
+d 1F7A 3C5 300
+d 1F7B 3C5 301
+d 1F7C 3C9 300
+d 1F7D 3C9 301
+d 1F80 3B1 313 345
+d 1F81 3B1 314 345
+d 1F82 3B1 313 300 345
+d 1F83 3B1 314 300 345
+d 1F84 3B1 313 301 345
+d 1F85 3B1 314 301 345
+d 1F86 3B1 313 342 345
+d 1F87 3B1 314 342 345
+d 1F88 391 313 345
+d 1F89 391 314 345
+d 1F8A 391 313 300 345
+d 1F8B 391 314 300 345
+d 1F8C 391 313 301 345
+d 1F8D 391 314 301 345
+d 1F8E 391 313 342 345
+d 1F8F 391 314 342 345
+d 1F90 3B7 313 345
+d 1F91 3B7 314 345
+d 1F92 3B7 313 300 345
+d 1F93 3B7 314 300 345
+d 1F94 3B7 313 301 345
+d 1F95 3B7 314 301 345
+d 1F96 3B7 313 342 345
+d 1F97 3B7 314 342 345
+d 1F98 397 313 345
+d 1F99 397 314 345
+d 1F9A 397 313 300 345
+d 1F9B 397 314 300 345
+d 1F9C 397 313 301 345
+d 1F9D 397 314 301 345
+d 1F9E 397 313 342 345
+d 1F9F 397 314 342 345
+d 1FA0 3C9 313 345
+d 1FA1 3C9 314 345
+d 1FA2 3C9 313 300 345
+d 1FA3 3C9 314 300 345
+d 1FA4 3C9 313 301 345
+d 1FA5 3C9 314 301 345
+d 1FA6 3C9 313 342 345
+d 1FA7 3C9 314 342 345
+d 1FA8 3A9 313 345
+d 1FA9 3A9 314 345
+d 1FAA 3A9 313 300 345
+d 1FAB 3A9 314 300 345
+d 1FAC 3A9 313 301 345
+d 1FAD 3A9 314 301 345
+d 1FAE 3A9 313 342 345
+d 1FAF 3A9 314 342 345
+d 1FB0 3B1 306
+d 1FB1 3B1 304
+d 1FB2 3B1 300 345
+d 1FB3 3B1 345
+d 1FB4 3B1 301 345
+d 1FB6 3B1 342
+d 1FB7 3B1 342 345
+d 1FB8 391 306
+d 1FB9 391 304
+d 1FBA 391 300
+d 1FBB 391 301
+d 1FBC 391 345
+d 1FBE 3B9
+d 1FC1 A8 342
+d 1FC2 3B7 300 345
+d 1FC3 3B7 345
+d 1FC4 3B7 301 345
+d 1FC6 3B7 342
+d 1FC7 3B7 342 345
+d 1FC8 395 300
+d 1FC9 395 301
+d 1FCA 397 300
+d 1FCB 397 301
+d 1FCC 397 345
+d 1FCD 1FBF 300
+d 1FCE 1FBF 301
+d 1FCF 1FBF 342
+d 1FD0 3B9 306
+d 1FD1 3B9 304
+d 1FD2 3B9 308 300
+d 1FD3 3B9 308 301
+d 1FD6 3B9 342
+d 1FD7 3B9 308 342
+d 1FD8 399 306
+d 1FD9 399 304
+d 1FDA 399 300
+d 1FDB 399 301
+d 1FDD 1FFE 300
+d 1FDE 1FFE 301
+d 1FDF 1FFE 342
+d 1FE0 3C5 306
+d 1FE1 3C5 304
+d 1FE2 3C5 308 300
+d 1FE3 3C5 308 301
+d 1FE4 3C1 313
+d 1FE5 3C1 314
+d 1FE6 3C5 342
+d 1FE7 3C5 308 342
+d 1FE8 3A5 306
+d 1FE9 3A5 304
+d 1FEA 3A5 300
+d 1FEB 3A5 301
+d 1FEC 3A1 314
+d 1FED A8 300
+d 1FEE A8 301
+d 1FEF 60
+d 1FF2 3C9 300 345
+d 1FF3 3C9 345
+d 1FF4 3C9 301 345
+d 1FF6 3C9 342
+d 1FF7 3C9 342 345
+d 1FF8 39F 300
+d 1FF9 39F 301
+d 1FFA 3A9 300
+d 1FFB 3A9 301
+d 1FFC 3A9 345
+d 1FFD B4
+d 2000 2002
+d 2001 2003
+d 2126 3A9
+d 212A 4B
+d 212B 41 30A
+d 219A 2190 338
+d 219B 2192 338
+d 21AE 2194 338
+d 21CD 21D0 338
+d 21CE 21D4 338
+d 21CF 21D2 338
+d 2204 2203 338
+d 2209 2208 338
+d 220C 220B 338
+d 2224 2223 338
+d 2226 2225 338
+d 2241 223C 338
+d 2244 2243 338
+d 2247 2245 338
+d 2249 2248 338
+d 2260 3D 338
+d 2262 2261 338
+d 226D 224D 338
+d 226E 3C 338
+d 226F 3E 338
+d 2270 2264 338
+d 2271 2265 338
+d 2274 2272 338
+d 2275 2273 338
+d 2278 2276 338
+d 2279 2277 338
+d 2280 227A 338
+d 2281 227B 338
+d 2284 2282 338
+d 2285 2283 338
+d 2288 2286 338
+d 2289 2287 338
+d 22AC 22A2 338
+d 22AD 22A8 338
+d 22AE 22A9 338
+d 22AF 22AB 338
+d 22E0 227C 338
+d 22E1 227D 338
+d 22E2 2291 338
+d 22E3 2292 338
+d 22EA 22B2 338
+d 22EB 22B3 338
+d 22EC 22B4 338
+d 22ED 22B5 338
+d 2329 3008
+d 232A 3009
+d 2ADC 2ADD 338
+d 304C 304B 3099
+d 304E 304D 3099
+d 3050 304F 3099
+d 3052 3051 3099
+d 3054 3053 3099
+d 3056 3055 3099
+d 3058 3057 3099
+d 305A 3059 3099
+d 305C 305B 3099
+d 305E 305D 3099
+d 3060 305F 3099
+d 3062 3061 3099
+d 3065 3064 3099
+d 3067 3066 3099
+d 3069 3068 3099
+d 3070 306F 3099
+d 3071 306F 309A
+d 3073 3072 3099
+d 3074 3072 309A
+d 3076 3075 3099
+d 3077 3075 309A
+d 3079 3078 3099
+d 307A 3078 309A
+d 307C 307B 3099
+d 307D 307B 309A
+d 3094 3046 3099
+d 309E 309D 3099
+d 30AC 30AB 3099
+d 30AE 30AD 3099
+d 30B0 30AF 3099
+d 30B2 30B1 3099
+d 30B4 30B3 3099
+d 30B6 30B5 3099
+d 30B8 30B7 3099
+d 30BA 30B9 3099
+d 30BC 30BB 3099
+d 30BE 30BD 3099
+d 30C0 30BF 3099
+d 30C2 30C1 3099
+d 30C5 30C4 3099
+d 30C7 30C6 3099
+d 30C9 30C8 3099
+d 30D0 30CF 3099
+d 30D1 30CF 309A
+d 30D3 30D2 3099
+d 30D4 30D2 309A
+d 30D6 30D5 3099
+d 30D7 30D5 309A
+d 30D9 30D8 3099
+d 30DA 30D8 309A
+d 30DC 30DB 3099
+d 30DD 30DB 309A
+d 30F4 30A6 3099
+d 30F7 30EF 3099
+d 30F8 30F0 3099
+d 30F9 30F1 3099
+d 30FA 30F2 3099
+d 30FE 30FD 3099
+d F900 8C48
+d F901 66F4
+d F902 8ECA
+d F903 8CC8
+d F904 6ED1
+d F905 4E32
+d F906 53E5
+d F907 9F9C
+d F908 9F9C
+d F909 5951
+d F90A 91D1
+d F90B 5587
+d F90C 5948
+d F90D 61F6
+d F90E 7669
+d F90F 7F85
+d F910 863F
+d F911 87BA
+d F912 88F8
+d F913 908F
+d F914 6A02
+d F915 6D1B
+d F916 70D9
+d F917 73DE
+d F918 843D
+d F919 916A
+d F91A 99F1
+d F91B 4E82
+d F91C 5375
+d F91D 6B04
+d F91E 721B
+d F91F 862D
+d F920 9E1E
+d F921 5D50
+d F922 6FEB
+d F923 85CD
+d F924 8964
+d F925 62C9
+d F926 81D8
+d F927 881F
+d F928 5ECA
+d F929 6717
+d F92A 6D6A
+d F92B 72FC
+d F92C 90CE
+d F92D 4F86
+d F92E 51B7
+d F92F 52DE
+d F930 64C4
+d F931 6AD3
+d F932 7210
+d F933 76E7
+d F934 8001
+d F935 8606
+d F936 865C
+d F937 8DEF
+d F938 9732
+d F939 9B6F
+d F93A 9DFA
+d F93B 788C
+d F93C 797F
+d F93D 7DA0
+d F93E 83C9
+d F93F 9304
+d F940 9E7F
+d F941 8AD6
+d F942 58DF
+d F943 5F04
+d F944 7C60
+d F945 807E
+d F946 7262
+d F947 78CA
+d F948 8CC2
+d F949 96F7
+d F94A 58D8
+d F94B 5C62
+d F94C 6A13
+d F94D 6DDA
+d F94E 6F0F
+d F94F 7D2F
+d F950 7E37
+d F951 964B
+d F952 52D2
+d F953 808B
+d F954 51DC
+d F955 51CC
+d F956 7A1C
+d F957 7DBE
+d F958 83F1
+d F959 9675
+d F95A 8B80
+d F95B 62CF
+d F95C 6A02
+d F95D 8AFE
+d F95E 4E39
+d F95F 5BE7
+d F960 6012
+d F961 7387
+d F962 7570
+d F963 5317
+d F964 78FB
+d F965 4FBF
+d F966 5FA9
+d F967 4E0D
+d F968 6CCC
+d F969 6578
+d F96A 7D22
+d F96B 53C3
+d F96C 585E
+d F96D 7701
+d F96E 8449
+d F96F 8AAA
+d F970 6BBA
+d F971 8FB0
+d F972 6C88
+d F973 62FE
+d F974 82E5
+d F975 63A0
+d F976 7565
+d F977 4EAE
+d F978 5169
+d F979 51C9
+d F97A 6881
+d F97B 7CE7
+d F97C 826F
+d F97D 8AD2
+d F97E 91CF
+d F97F 52F5
+d F980 5442
+d F981 5973
+d F982 5EEC
+d F983 65C5
+d F984 6FFE
+d F985 792A
+d F986 95AD
+d F987 9A6A
+d F988 9E97
+d F989 9ECE
+d F98A 529B
+d F98B 66C6
+d F98C 6B77
+d F98D 8F62
+d F98E 5E74
+d F98F 6190
+d F990 6200
+d F991 649A
+d F992 6F23
+d F993 7149
+d F994 7489
+d F995 79CA
+d F996 7DF4
+d F997 806F
+d F998 8F26
+d F999 84EE
+d F99A 9023
+d F99B 934A
+d F99C 5217
+d F99D 52A3
+d F99E 54BD
+d F99F 70C8
+d F9A0 88C2
+d F9A1 8AAA
+d F9A2 5EC9
+d F9A3 5FF5
+d F9A4 637B
+d F9A5 6BAE
+d F9A6 7C3E
+d F9A7 7375
+d F9A8 4EE4
+d F9A9 56F9
+d F9AA 5BE7
+d F9AB 5DBA
+d F9AC 601C
+d F9AD 73B2
+d F9AE 7469
+d F9AF 7F9A
+d F9B0 8046
+d F9B1 9234
+d F9B2 96F6
+d F9B3 9748
+d F9B4 9818
+d F9B5 4F8B
+d F9B6 79AE
+d F9B7 91B4
+d F9B8 96B8
+d F9B9 60E1
+d F9BA 4E86
+d F9BB 50DA
+d F9BC 5BEE
+d F9BD 5C3F
+d F9BE 6599
+d F9BF 6A02
+d F9C0 71CE
+d F9C1 7642
+d F9C2 84FC
+d F9C3 907C
+d F9C4 9F8D
+d F9C5 6688
+d F9C6 962E
+d F9C7 5289
+d F9C8 677B
+d F9C9 67F3
+d F9CA 6D41
+d F9CB 6E9C
+d F9CC 7409
+d F9CD 7559
+d F9CE 786B
+d F9CF 7D10
+d F9D0 985E
+d F9D1 516D
+d F9D2 622E
+d F9D3 9678
+d F9D4 502B
+d F9D5 5D19
+d F9D6 6DEA
+d F9D7 8F2A
+d F9D8 5F8B
+d F9D9 6144
+d F9DA 6817
+d F9DB 7387
+d F9DC 9686
+d F9DD 5229
+d F9DE 540F
+d F9DF 5C65
+d F9E0 6613
+d F9E1 674E
+d F9E2 68A8
+d F9E3 6CE5
+d F9E4 7406
+d F9E5 75E2
+d F9E6 7F79
+d F9E7 88CF
+d F9E8 88E1
+d F9E9 91CC
+d F9EA 96E2
+d F9EB 533F
+d F9EC 6EBA
+d F9ED 541D
+d F9EE 71D0
+d F9EF 7498
+d F9F0 85FA
+d F9F1 96A3
+d F9F2 9C57
+d F9F3 9E9F
+d F9F4 6797
+d F9F5 6DCB
+d F9F6 81E8
+d F9F7 7ACB
+d F9F8 7B20
+d F9F9 7C92
+d F9FA 72C0
+d F9FB 7099
+d F9FC 8B58
+d F9FD 4EC0
+d F9FE 8336
+d F9FF 523A
+d FA00 5207
+d FA01 5EA6
+d FA02 62D3
+d FA03 7CD6
+d FA04 5B85
+d FA05 6D1E
+d FA06 66B4
+d FA07 8F3B
+d FA08 884C
+d FA09 964D
+d FA0A 898B
+d FA0B 5ED3
+d FA0C 5140
+d FA0D 55C0
+d FA10 585A
+d FA12 6674
+d FA15 51DE
+d FA16 732A
+d FA17 76CA
+d FA18 793C
+d FA19 795E
+d FA1A 7965
+d FA1B 798F
+d FA1C 9756
+d FA1D 7CBE
+d FA1E 7FBD
+d FA20 8612
+d FA22 8AF8
+d FA25 9038
+d FA26 90FD
+d FA2A 98EF
+d FA2B 98FC
+d FA2C 9928
+d FA2D 9DB4
+d FA2E 90DE
+d FA2F 96B7
+d FA30 4FAE
+d FA31 50E7
+d FA32 514D
+d FA33 52C9
+d FA34 52E4
+d FA35 5351
+d FA36 559D
+d FA37 5606
+d FA38 5668
+d FA39 5840
+d FA3A 58A8
+d FA3B 5C64
+d FA3C 5C6E
+d FA3D 6094
+d FA3E 6168
+d FA3F 618E
+d FA40 61F2
+d FA41 654F
+d FA42 65E2
+d FA43 6691
+d FA44 6885
+d FA45 6D77
+d FA46 6E1A
+d FA47 6F22
+d FA48 716E
+d FA49 722B
+d FA4A 7422
+d FA4B 7891
+d FA4C 793E
+d FA4D 7949
+d FA4E 7948
+d FA4F 7950
+d FA50 7956
+d FA51 795D
+d FA52 798D
+d FA53 798E
+d FA54 7A40
+d FA55 7A81
+d FA56 7BC0
+d FA57 7DF4
+d FA58 7E09
+d FA59 7E41
+d FA5A 7F72
+d FA5B 8005
+d FA5C 81ED
+d FA5D 8279
+d FA5E 8279
+d FA5F 8457
+d FA60 8910
+d FA61 8996
+d FA62 8B01
+d FA63 8B39
+d FA64 8CD3
+d FA65 8D08
+d FA66 8FB6
+d FA67 9038
+d FA68 96E3
+d FA69 97FF
+d FA6A 983B
+d FA6B 6075
+d FA6C 242EE
+d FA6D 8218
+d FA70 4E26
+d FA71 51B5
+d FA72 5168
+d FA73 4F80
+d FA74 5145
+d FA75 5180
+d FA76 52C7
+d FA77 52FA
+d FA78 559D
+d FA79 5555
+d FA7A 5599
+d FA7B 55E2
+d FA7C 585A
+d FA7D 58B3
+d FA7E 5944
+d FA7F 5954
+d FA80 5A62
+d FA81 5B28
+d FA82 5ED2
+d FA83 5ED9
+d FA84 5F69
+d FA85 5FAD
+d FA86 60D8
+d FA87 614E
+d FA88 6108
+d FA89 618E
+d FA8A 6160
+d FA8B 61F2
+d FA8C 6234
+d FA8D 63C4
+d FA8E 641C
+d FA8F 6452
+d FA90 6556
+d FA91 6674
+d FA92 6717
+d FA93 671B
+d FA94 6756
+d FA95 6B79
+d FA96 6BBA
+d FA97 6D41
+d FA98 6EDB
+d FA99 6ECB
+d FA9A 6F22
+d FA9B 701E
+d FA9C 716E
+d FA9D 77A7
+d FA9E 7235
+d FA9F 72AF
+d FAA0 732A
+d FAA1 7471
+d FAA2 7506
+d FAA3 753B
+d FAA4 761D
+d FAA5 761F
+d FAA6 76CA
+d FAA7 76DB
+d FAA8 76F4
+d FAA9 774A
+d FAAA 7740
+d FAAB 78CC
+d FAAC 7AB1
+d FAAD 7BC0
+d FAAE 7C7B
+d FAAF 7D5B
+d FAB0 7DF4
+d FAB1 7F3E
+d FAB2 8005
+d FAB3 8352
+d FAB4 83EF
+d FAB5 8779
+d FAB6 8941
+d FAB7 8986
+d FAB8 8996
+d FAB9 8ABF
+d FABA 8AF8
+d FABB 8ACB
+d FABC 8B01
+d FABD 8AFE
+d FABE 8AED
+d FABF 8B39
+d FAC0 8B8A
+d FAC1 8D08
+d FAC2 8F38
+d FAC3 9072
+d FAC4 9199
+d FAC5 9276
+d FAC6 967C
+d FAC7 96E3
+d FAC8 9756
+d FAC9 97DB
+d FACA 97FF
+d FACB 980B
+d FACC 983B
+d FACD 9B12
+d FACE 9F9C
+d FACF 2284A
+d FAD0 22844
+d FAD1 233D5
+d FAD2 3B9D
+d FAD3 4018
+d FAD4 4039
+d FAD5 25249
+d FAD6 25CD0
+d FAD7 27ED3
+d FAD8 9F43
+d FAD9 9F8E
+d FB1D 5D9 5B4
+d FB1F 5F2 5B7
+d FB2A 5E9 5C1
+d FB2B 5E9 5C2
+d FB2C 5E9 5BC 5C1
+d FB2D 5E9 5BC 5C2
+d FB2E 5D0 5B7
+d FB2F 5D0 5B8
+d FB30 5D0 5BC
+d FB31 5D1 5BC
+d FB32 5D2 5BC
+d FB33 5D3 5BC
+d FB34 5D4 5BC
+d FB35 5D5 5BC
+d FB36 5D6 5BC
+d FB38 5D8 5BC
+d FB39 5D9 5BC
+d FB3A 5DA 5BC
+d FB3B 5DB 5BC
+d FB3C 5DC 5BC
+d FB3E 5DE 5BC
+d FB40 5E0 5BC
+d FB41 5E1 5BC
+d FB43 5E3 5BC
+d FB44 5E4 5BC
+d FB46 5E6 5BC
+d FB47 5E7 5BC
+d FB48 5E8 5BC
+d FB49 5E9 5BC
+d FB4A 5EA 5BC
+d FB4B 5D5 5B9
+d FB4C 5D1 5BF
+d FB4D 5DB 5BF
+d FB4E 5E4 5BF
+d 1109A 11099 110BA
+d 1109C 1109B 110BA
+d 110AB 110A5 110BA
+d 1112E 11131 11127
+d 1112F 11132 11127
+d 1134B 11347 1133E
+d 1134C 11347 11357
+d 114BB 114B9 114BA
+d 114BC 114B9 114B0
+d 114BE 114B9 114BD
+d 115BA 115B8 115AF
+d 115BB 115B9 115AF
+d 11938 11935 11930
+d 1D15E 1D157 1D165
+d 1D15F 1D158 1D165
+d 1D160 1D158 1D165 1D16E
+d 1D161 1D158 1D165 1D16F
+d 1D162 1D158 1D165 1D170
+d 1D163 1D158 1D165 1D171
+d 1D164 1D158 1D165 1D172
+d 1D1BB 1D1B9 1D165
+d 1D1BC 1D1BA 1D165
+d 1D1BD 1D1B9 1D165 1D16E
+d 1D1BE 1D1BA 1D165 1D16E
+d 1D1BF 1D1B9 1D165 1D16F
+d 1D1C0 1D1BA 1D165 1D16F
+d 2F800 4E3D
+d 2F801 4E38
+d 2F802 4E41
+d 2F803 20122
+d 2F804 4F60
+d 2F805 4FAE
+d 2F806 4FBB
+d 2F807 5002
+d 2F808 507A
+d 2F809 5099
+d 2F80A 50E7
+d 2F80B 50CF
+d 2F80C 349E
+d 2F80D 2063A
+d 2F80E 514D
+d 2F80F 5154
+d 2F810 5164
+d 2F811 5177
+d 2F812 2051C
+d 2F813 34B9
+d 2F814 5167
+d 2F815 518D
+d 2F816 2054B
+d 2F817 5197
+d 2F818 51A4
+d 2F819 4ECC
+d 2F81A 51AC
+d 2F81B 51B5
+d 2F81C 291DF
+d 2F81D 51F5
+d 2F81E 5203
+d 2F81F 34DF
+d 2F820 523B
+d 2F821 5246
+d 2F822 5272
+d 2F823 5277
+d 2F824 3515
+d 2F825 52C7
+d 2F826 52C9
+d 2F827 52E4
+d 2F828 52FA
+d 2F829 5305
+d 2F82A 5306
+d 2F82B 5317
+d 2F82C 5349
+d 2F82D 5351
+d 2F82E 535A
+d 2F82F 5373
+d 2F830 537D
+d 2F831 537F
+d 2F832 537F
+d 2F833 537F
+d 2F834 20A2C
+d 2F835 7070
+d 2F836 53CA
+d 2F837 53DF
+d 2F838 20B63
+d 2F839 53EB
+d 2F83A 53F1
+d 2F83B 5406
+d 2F83C 549E
+d 2F83D 5438
+d 2F83E 5448
+d 2F83F 5468
+d 2F840 54A2
+d 2F841 54F6
+d 2F842 5510
+d 2F843 5553
+d 2F844 5563
+d 2F845 5584
+d 2F846 5584
+d 2F847 5599
+d 2F848 55AB
+d 2F849 55B3
+d 2F84A 55C2
+d 2F84B 5716
+d 2F84C 5606
+d 2F84D 5717
+d 2F84E 5651
+d 2F84F 5674
+d 2F850 5207
+d 2F851 58EE
+d 2F852 57CE
+d 2F853 57F4
+d 2F854 580D
+d 2F855 578B
+d 2F856 5832
+d 2F857 5831
+d 2F858 58AC
+d 2F859 214E4
+d 2F85A 58F2
+d 2F85B 58F7
+d 2F85C 5906
+d 2F85D 591A
+d 2F85E 5922
+d 2F85F 5962
+d 2F860 216A8
+d 2F861 216EA
+d 2F862 59EC
+d 2F863 5A1B
+d 2F864 5A27
+d 2F865 59D8
+d 2F866 5A66
+d 2F867 36EE
+d 2F868 36FC
+d 2F869 5B08
+d 2F86A 5B3E
+d 2F86B 5B3E
+d 2F86C 219C8
+d 2F86D 5BC3
+d 2F86E 5BD8
+d 2F86F 5BE7
+d 2F870 5BF3
+d 2F871 21B18
+d 2F872 5BFF
+d 2F873 5C06
+d 2F874 5F53
+d 2F875 5C22
+d 2F876 3781
+d 2F877 5C60
+d 2F878 5C6E
+d 2F879 5CC0
+d 2F87A 5C8D
+d 2F87B 21DE4
+d 2F87C 5D43
+d 2F87D 21DE6
+d 2F87E 5D6E
+d 2F87F 5D6B
+d 2F880 5D7C
+d 2F881 5DE1
+d 2F882 5DE2
+d 2F883 382F
+d 2F884 5DFD
+d 2F885 5E28
+d 2F886 5E3D
+d 2F887 5E69
+d 2F888 3862
+d 2F889 22183
+d 2F88A 387C
+d 2F88B 5EB0
+d 2F88C 5EB3
+d 2F88D 5EB6
+d 2F88E 5ECA
+d 2F88F 2A392
+d 2F890 5EFE
+d 2F891 22331
+d 2F892 22331
+d 2F893 8201
+d 2F894 5F22
+d 2F895 5F22
+d 2F896 38C7
+d 2F897 232B8
+d 2F898 261DA
+d 2F899 5F62
+d 2F89A 5F6B
+d 2F89B 38E3
+d 2F89C 5F9A
+d 2F89D 5FCD
+d 2F89E 5FD7
+d 2F89F 5FF9
+d 2F8A0 6081
+d 2F8A1 393A
+d 2F8A2 391C
+d 2F8A3 6094
+d 2F8A4 226D4
+d 2F8A5 60C7
+d 2F8A6 6148
+d 2F8A7 614C
+d 2F8A8 614E
+d 2F8A9 614C
+d 2F8AA 617A
+d 2F8AB 618E
+d 2F8AC 61B2
+d 2F8AD 61A4
+d 2F8AE 61AF
+d 2F8AF 61DE
+d 2F8B0 61F2
+d 2F8B1 61F6
+d 2F8B2 6210
+d 2F8B3 621B
+d 2F8B4 625D
+d 2F8B5 62B1
+d 2F8B6 62D4
+d 2F8B7 6350
+d 2F8B8 22B0C
+d 2F8B9 633D
+d 2F8BA 62FC
+d 2F8BB 6368
+d 2F8BC 6383
+d 2F8BD 63E4
+d 2F8BE 22BF1
+d 2F8BF 6422
+d 2F8C0 63C5
+d 2F8C1 63A9
+d 2F8C2 3A2E
+d 2F8C3 6469
+d 2F8C4 647E
+d 2F8C5 649D
+d 2F8C6 6477
+d 2F8C7 3A6C
+d 2F8C8 654F
+d 2F8C9 656C
+d 2F8CA 2300A
+d 2F8CB 65E3
+d 2F8CC 66F8
+d 2F8CD 6649
+d 2F8CE 3B19
+d 2F8CF 6691
+d 2F8D0 3B08
+d 2F8D1 3AE4
+d 2F8D2 5192
+d 2F8D3 5195
+d 2F8D4 6700
+d 2F8D5 669C
+d 2F8D6 80AD
+d 2F8D7 43D9
+d 2F8D8 6717
+d 2F8D9 671B
+d 2F8DA 6721
+d 2F8DB 675E
+d 2F8DC 6753
+d 2F8DD 233C3
+d 2F8DE 3B49
+d 2F8DF 67FA
+d 2F8E0 6785
+d 2F8E1 6852
+d 2F8E2 6885
+d 2F8E3 2346D
+d 2F8E4 688E
+d 2F8E5 681F
+d 2F8E6 6914
+d 2F8E7 3B9D
+d 2F8E8 6942
+d 2F8E9 69A3
+d 2F8EA 69EA
+d 2F8EB 6AA8
+d 2F8EC 236A3
+d 2F8ED 6ADB
+d 2F8EE 3C18
+d 2F8EF 6B21
+d 2F8F0 238A7
+d 2F8F1 6B54
+d 2F8F2 3C4E
+d 2F8F3 6B72
+d 2F8F4 6B9F
+d 2F8F5 6BBA
+d 2F8F6 6BBB
+d 2F8F7 23A8D
+d 2F8F8 21D0B
+d 2F8F9 23AFA
+d 2F8FA 6C4E
+d 2F8FB 23CBC
+d 2F8FC 6CBF
+d 2F8FD 6CCD
+d 2F8FE 6C67
+d 2F8FF 6D16
+d 2F900 6D3E
+d 2F901 6D77
+d 2F902 6D41
+d 2F903 6D69
+d 2F904 6D78
+d 2F905 6D85
+d 2F906 23D1E
+d 2F907 6D34
+d 2F908 6E2F
+d 2F909 6E6E
+d 2F90A 3D33
+d 2F90B 6ECB
+d 2F90C 6EC7
+d 2F90D 23ED1
+d 2F90E 6DF9
+d 2F90F 6F6E
+d 2F910 23F5E
+d 2F911 23F8E
+d 2F912 6FC6
+d 2F913 7039
+d 2F914 701E
+d 2F915 701B
+d 2F916 3D96
+d 2F917 704A
+d 2F918 707D
+d 2F919 7077
+d 2F91A 70AD
+d 2F91B 20525
+d 2F91C 7145
+d 2F91D 24263
+d 2F91E 719C
+d 2F91F 243AB
+d 2F920 7228
+d 2F921 7235
+d 2F922 7250
+d 2F923 24608
+d 2F924 7280
+d 2F925 7295
+d 2F926 24735
+d 2F927 24814
+d 2F928 737A
+d 2F929 738B
+d 2F92A 3EAC
+d 2F92B 73A5
+d 2F92C 3EB8
+d 2F92D 3EB8
+d 2F92E 7447
+d 2F92F 745C
+d 2F930 7471
+d 2F931 7485
+d 2F932 74CA
+d 2F933 3F1B
+d 2F934 7524
+d 2F935 24C36
+d 2F936 753E
+d 2F937 24C92
+d 2F938 7570
+d 2F939 2219F
+d 2F93A 7610
+d 2F93B 24FA1
+d 2F93C 24FB8
+d 2F93D 25044
+d 2F93E 3FFC
+d 2F93F 4008
+d 2F940 76F4
+d 2F941 250F3
+d 2F942 250F2
+d 2F943 25119
+d 2F944 25133
+d 2F945 771E
+d 2F946 771F
+d 2F947 771F
+d 2F948 774A
+d 2F949 4039
+d 2F94A 778B
+d 2F94B 4046
+d 2F94C 4096
+d 2F94D 2541D
+d 2F94E 784E
+d 2F94F 788C
+d 2F950 78CC
+d 2F951 40E3
+d 2F952 25626
+d 2F953 7956
+d 2F954 2569A
+d 2F955 256C5
+d 2F956 798F
+d 2F957 79EB
+d 2F958 412F
+d 2F959 7A40
+d 2F95A 7A4A
+d 2F95B 7A4F
+d 2F95C 2597C
+d 2F95D 25AA7
+d 2F95E 25AA7
+d 2F95F 7AEE
+d 2F960 4202
+d 2F961 25BAB
+d 2F962 7BC6
+d 2F963 7BC9
+d 2F964 4227
+d 2F965 25C80
+d 2F966 7CD2
+d 2F967 42A0
+d 2F968 7CE8
+d 2F969 7CE3
+d 2F96A 7D00
+d 2F96B 25F86
+d 2F96C 7D63
+d 2F96D 4301
+d 2F96E 7DC7
+d 2F96F 7E02
+d 2F970 7E45
+d 2F971 4334
+d 2F972 26228
+d 2F973 26247
+d 2F974 4359
+d 2F975 262D9
+d 2F976 7F7A
+d 2F977 2633E
+d 2F978 7F95
+d 2F979 7FFA
+d 2F97A 8005
+d 2F97B 264DA
+d 2F97C 26523
+d 2F97D 8060
+d 2F97E 265A8
+d 2F97F 8070
+d 2F980 2335F
+d 2F981 43D5
+d 2F982 80B2
+d 2F983 8103
+d 2F984 440B
+d 2F985 813E
+d 2F986 5AB5
+d 2F987 267A7
+d 2F988 267B5
+d 2F989 23393
+d 2F98A 2339C
+d 2F98B 8201
+d 2F98C 8204
+d 2F98D 8F9E
+d 2F98E 446B
+d 2F98F 8291
+d 2F990 828B
+d 2F991 829D
+d 2F992 52B3
+d 2F993 82B1
+d 2F994 82B3
+d 2F995 82BD
+d 2F996 82E6
+d 2F997 26B3C
+d 2F998 82E5
+d 2F999 831D
+d 2F99A 8363
+d 2F99B 83AD
+d 2F99C 8323
+d 2F99D 83BD
+d 2F99E 83E7
+d 2F99F 8457
+d 2F9A0 8353
+d 2F9A1 83CA
+d 2F9A2 83CC
+d 2F9A3 83DC
+d 2F9A4 26C36
+d 2F9A5 26D6B
+d 2F9A6 26CD5
+d 2F9A7 452B
+d 2F9A8 84F1
+d 2F9A9 84F3
+d 2F9AA 8516
+d 2F9AB 273CA
+d 2F9AC 8564
+d 2F9AD 26F2C
+d 2F9AE 455D
+d 2F9AF 4561
+d 2F9B0 26FB1
+d 2F9B1 270D2
+d 2F9B2 456B
+d 2F9B3 8650
+d 2F9B4 865C
+d 2F9B5 8667
+d 2F9B6 8669
+d 2F9B7 86A9
+d 2F9B8 8688
+d 2F9B9 870E
+d 2F9BA 86E2
+d 2F9BB 8779
+d 2F9BC 8728
+d 2F9BD 876B
+d 2F9BE 8786
+d 2F9BF 45D7
+d 2F9C0 87E1
+d 2F9C1 8801
+d 2F9C2 45F9
+d 2F9C3 8860
+d 2F9C4 8863
+d 2F9C5 27667
+d 2F9C6 88D7
+d 2F9C7 88DE
+d 2F9C8 4635
+d 2F9C9 88FA
+d 2F9CA 34BB
+d 2F9CB 278AE
+d 2F9CC 27966
+d 2F9CD 46BE
+d 2F9CE 46C7
+d 2F9CF 8AA0
+d 2F9D0 8AED
+d 2F9D1 8B8A
+d 2F9D2 8C55
+d 2F9D3 27CA8
+d 2F9D4 8CAB
+d 2F9D5 8CC1
+d 2F9D6 8D1B
+d 2F9D7 8D77
+d 2F9D8 27F2F
+d 2F9D9 20804
+d 2F9DA 8DCB
+d 2F9DB 8DBC
+d 2F9DC 8DF0
+d 2F9DD 208DE
+d 2F9DE 8ED4
+d 2F9DF 8F38
+d 2F9E0 285D2
+d 2F9E1 285ED
+d 2F9E2 9094
+d 2F9E3 90F1
+d 2F9E4 9111
+d 2F9E5 2872E
+d 2F9E6 911B
+d 2F9E7 9238
+d 2F9E8 92D7
+d 2F9E9 92D8
+d 2F9EA 927C
+d 2F9EB 93F9
+d 2F9EC 9415
+d 2F9ED 28BFA
+d 2F9EE 958B
+d 2F9EF 4995
+d 2F9F0 95B7
+d 2F9F1 28D77
+d 2F9F2 49E6
+d 2F9F3 96C3
+d 2F9F4 5DB2
+d 2F9F5 9723
+d 2F9F6 29145
+d 2F9F7 2921A
+d 2F9F8 4A6E
+d 2F9F9 4A76
+d 2F9FA 97E0
+d 2F9FB 2940A
+d 2F9FC 4AB2
+d 2F9FD 29496
+d 2F9FE 980B
+d 2F9FF 980B
+d 2FA00 9829
+d 2FA01 295B6
+d 2FA02 98E2
+d 2FA03 4B33
+d 2FA04 9929
+d 2FA05 99A7
+d 2FA06 99C2
+d 2FA07 99FE
+d 2FA08 4BCE
+d 2FA09 29B30
+d 2FA0A 9B12
+d 2FA0B 9C40
+d 2FA0C 9CFD
+d 2FA0D 4CCE
+d 2FA0E 4CED
+d 2FA0F 9D67
+d 2FA10 2A0CE
+d 2FA11 4CF8
+d 2FA12 2A105
+d 2FA13 2A20E
+d 2FA14 2A291
+d 2FA15 9EBB
+d 2FA16 4D56
+d 2FA17 9EF9
+d 2FA18 9EFE
+d 2FA19 9F05
+d 2FA1A 9F0F
+d 2FA1B 9F16
+d 2FA1C 9F3B
+d 2FA1D 2A600
+k A0 20
+k A8 20 308
+k AA 61
+k AF 20 304
+k B2 32
+k B3 33
+k B4 20 301
+k B5 3BC
+k B8 20 327
+k B9 31
+k BA 6F
+k BC 31 2044 34
+k BD 31 2044 32
+k BE 33 2044 34
+k 132 49 4A
+k 133 69 6A
+k 13F 4C B7
+k 140 6C B7
+k 149 2BC 6E
+k 17F 73
+k 1C4 44 5A 30C
+k 1C5 44 7A 30C
+k 1C6 64 7A 30C
+k 1C7 4C 4A
+k 1C8 4C 6A
+k 1C9 6C 6A
+k 1CA 4E 4A
+k 1CB 4E 6A
+k 1CC 6E 6A
+k 1F1 44 5A
+k 1F2 44 7A
+k 1F3 64 7A
+k 2B0 68
+k 2B1 266
+k 2B2 6A
+k 2B3 72
+k 2B4 279
+k 2B5 27B
+k 2B6 281
+k 2B7 77
+k 2B8 79
+k 2D8 20 306
+k 2D9 20 307
+k 2DA 20 30A
+k 2DB 20 328
+k 2DC 20 303
+k 2DD 20 30B
+k 2E0 263
+k 2E1 6C
+k 2E2 73
+k 2E3 78
+k 2E4 295
+k 37A 20 345
+k 384 20 301
+k 385 20 308 301
+k 3D0 3B2
+k 3D1 3B8
+k 3D2 3A5
+k 3D3 3A5 301
+k 3D4 3A5 308
+k 3D5 3C6
+k 3D6 3C0
+k 3F0 3BA
+k 3F1 3C1
+k 3F2 3C2
+k 3F4 398
+k 3F5 3B5
+k 3F9 3A3
+k 587 565 582
+k 675 627 674
+k 676 648 674
+k 677 6C7 674
+k 678 64A 674
+k E33 E4D E32
+k EB3 ECD EB2
+k EDC EAB E99
+k EDD EAB EA1
+k F0C F0B
+k F77 FB2 F71 F80
+k F79 FB3 F71 F80
+k 10FC 10DC
+k 1D2C 41
+k 1D2D C6
+k 1D2E 42
+k 1D30 44
+k 1D31 45
+k 1D32 18E
+k 1D33 47
+k 1D34 48
+k 1D35 49
+k 1D36 4A
+k 1D37 4B
+k 1D38 4C
+k 1D39 4D
+k 1D3A 4E
+k 1D3C 4F
+k 1D3D 222
+k 1D3E 50
+k 1D3F 52
+k 1D40 54
+k 1D41 55
+k 1D42 57
+k 1D43 61
+k 1D44 250
+k 1D45 251
+k 1D46 1D02
+k 1D47 62
+k 1D48 64
+k 1D49 65
+k 1D4A 259
+k 1D4B 25B
+k 1D4C 25C
+k 1D4D 67
+k 1D4F 6B
+k 1D50 6D
+k 1D51 14B
+k 1D52 6F
+k 1D53 254
+k 1D54 1D16
+k 1D55 1D17
+k 1D56 70
+k 1D57 74
+k 1D58 75
+k 1D59 1D1D
+k 1D5A 26F
+k 1D5B 76
+k 1D5C 1D25
+k 1D5D 3B2
+k 1D5E 3B3
+k 1D5F 3B4
+k 1D60 3C6
+k 1D61 3C7
+k 1D62 69
+k 1D63 72
+k 1D64 75
+k 1D65 76
+k 1D66 3B2
+k 1D67 3B3
+k 1D68 3C1
+k 1D69 3C6
+k 1D6A 3C7
+k 1D78 43D
+k 1D9B 252
+k 1D9C 63
+k 1D9D 255
+k 1D9E F0
+k 1D9F 25C
+k 1DA0 66
+k 1DA1 25F
+k 1DA2 261
+k 1DA3 265
+k 1DA4 268
+k 1DA5 269
+k 1DA6 26A
+k 1DA7 1D7B
+k 1DA8 29D
+k 1DA9 26D
+k 1DAA 1D85
+k 1DAB 29F
+k 1DAC 271
+k 1DAD 270
+k 1DAE 272
+k 1DAF 273
+k 1DB0 274
+k 1DB1 275
+k 1DB2 278
+k 1DB3 282
+k 1DB4 283
+k 1DB5 1AB
+k 1DB6 289
+k 1DB7 28A
+k 1DB8 1D1C
+k 1DB9 28B
+k 1DBA 28C
+k 1DBB 7A
+k 1DBC 290
+k 1DBD 291
+k 1DBE 292
+k 1DBF 3B8
+k 1E9A 61 2BE
+k 1E9B 73 307
+k 1FBD 20 313
+k 1FBF 20 313
+k 1FC0 20 342
+k 1FC1 20 308 342
+k 1FCD 20 313 300
+k 1FCE 20 313 301
+k 1FCF 20 313 342
+k 1FDD 20 314 300
+k 1FDE 20 314 301
+k 1FDF 20 314 342
+k 1FED 20 308 300
+k 1FEE 20 308 301
+k 1FFD 20 301
+k 1FFE 20 314
+k 2000 20
+k 2001 20
+k 2002 20
+k 2003 20
+k 2004 20
+k 2005 20
+k 2006 20
+k 2007 20
+k 2008 20
+k 2009 20
+k 200A 20
+k 2011 2010
+k 2017 20 333
+k 2024 2E
+k 2025 2E 2E
+k 2026 2E 2E 2E
+k 202F 20
+k 2033 2032 2032
+k 2034 2032 2032 2032
+k 2036 2035 2035
+k 2037 2035 2035 2035
+k 203C 21 21
+k 203E 20 305
+k 2047 3F 3F
+k 2048 3F 21
+k 2049 21 3F
+k 2057 2032 2032 2032 2032
+k 205F 20
+k 2070 30
+k 2071 69
+k 2074 34
+k 2075 35
+k 2076 36
+k 2077 37
+k 2078 38
+k 2079 39
+k 207A 2B
+k 207B 2212
+k 207C 3D
+k 207D 28
+k 207E 29
+k 207F 6E
+k 2080 30
+k 2081 31
+k 2082 32
+k 2083 33
+k 2084 34
+k 2085 35
+k 2086 36
+k 2087 37
+k 2088 38
+k 2089 39
+k 208A 2B
+k 208B 2212
+k 208C 3D
+k 208D 28
+k 208E 29
+k 2090 61
+k 2091 65
+k 2092 6F
+k 2093 78
+k 2094 259
+k 2095 68
+k 2096 6B
+k 2097 6C
+k 2098 6D
+k 2099 6E
+k 209A 70
+k 209B 73
+k 209C 74
+k 20A8 52 73
+k 2100 61 2F 63
+k 2101 61 2F 73
+k 2102 43
+k 2103 B0 43
+k 2105 63 2F 6F
+k 2106 63 2F 75
+k 2107 190
+k 2109 B0 46
+k 210A 67
+k 210B 48
+k 210C 48
+k 210D 48
+k 210E 68
+k 210F 127
+k 2110 49
+k 2111 49
+k 2112 4C
+k 2113 6C
+k 2115 4E
+k 2116 4E 6F
+k 2119 50
+k 211A 51
+k 211B 52
+k 211C 52
+k 211D 52
+k 2120 53 4D
+k 2121 54 45 4C
+k 2122 54 4D
+k 2124 5A
+k 2128 5A
+k 212C 42
+k 212D 43
+k 212F 65
+k 2130 45
+k 2131 46
+k 2133 4D
+k 2134 6F
+k 2135 5D0
+k 2136 5D1
+k 2137 5D2
+k 2138 5D3
+k 2139 69
+k 213B 46 41 58
+k 213C 3C0
+k 213D 3B3
+k 213E 393
+k 213F 3A0
+k 2140 2211
+k 2145 44
+k 2146 64
+k 2147 65
+k 2148 69
+k 2149 6A
+k 2150 31 2044 37
+k 2151 31 2044 39
+k 2152 31 2044 31 30
+k 2153 31 2044 33
+k 2154 32 2044 33
+k 2155 31 2044 35
+k 2156 32 2044 35
+k 2157 33 2044 35
+k 2158 34 2044 35
+k 2159 31 2044 36
+k 215A 35 2044 36
+k 215B 31 2044 38
+k 215C 33 2044 38
+k 215D 35 2044 38
+k 215E 37 2044 38
+k 215F 31 2044
+k 2160 49
+k 2161 49 49
+k 2162 49 49 49
+k 2163 49 56
+k 2164 56
+k 2165 56 49
+k 2166 56 49 49
+k 2167 56 49 49 49
+k 2168 49 58
+k 2169 58
+k 216A 58 49
+k 216B 58 49 49
+k 216C 4C
+k 216D 43
+k 216E 44
+k 216F 4D
+k 2170 69
+k 2171 69 69
+k 2172 69 69 69
+k 2173 69 76
+k 2174 76
+k 2175 76 69
+k 2176 76 69 69
+k 2177 76 69 69 69
+k 2178 69 78
+k 2179 78
+k 217A 78 69
+k 217B 78 69 69
+k 217C 6C
+k 217D 63
+k 217E 64
+k 217F 6D
+k 2189 30 2044 33
+k 222C 222B 222B
+k 222D 222B 222B 222B
+k 222F 222E 222E
+k 2230 222E 222E 222E
+k 2460 31
+k 2461 32
+k 2462 33
+k 2463 34
+k 2464 35
+k 2465 36
+k 2466 37
+k 2467 38
+k 2468 39
+k 2469 31 30
+k 246A 31 31
+k 246B 31 32
+k 246C 31 33
+k 246D 31 34
+k 246E 31 35
+k 246F 31 36
+k 2470 31 37
+k 2471 31 38
+k 2472 31 39
+k 2473 32 30
+k 2474 28 31 29
+k 2475 28 32 29
+k 2476 28 33 29
+k 2477 28 34 29
+k 2478 28 35 29
+k 2479 28 36 29
+k 247A 28 37 29
+k 247B 28 38 29
+k 247C 28 39 29
+k 247D 28 31 30 29
+k 247E 28 31 31 29
+k 247F 28 31 32 29
+k 2480 28 31 33 29
+k 2481 28 31 34 29
+k 2482 28 31 35 29
+k 2483 28 31 36 29
+k 2484 28 31 37 29
+k 2485 28 31 38 29
+k 2486 28 31 39 29
+k 2487 28 32 30 29
+k 2488 31 2E
+k 2489 32 2E
+k 248A 33 2E
+k 248B 34 2E
+k 248C 35 2E
+k 248D 36 2E
+k 248E 37 2E
+k 248F 38 2E
+k 2490 39 2E
+k 2491 31 30 2E
+k 2492 31 31 2E
+k 2493 31 32 2E
+k 2494 31 33 2E
+k 2495 31 34 2E
+k 2496 31 35 2E
+k 2497 31 36 2E
+k 2498 31 37 2E
+k 2499 31 38 2E
+k 249A 31 39 2E
+k 249B 32 30 2E
+k 249C 28 61 29
+k 249D 28 62 29
+k 249E 28 63 29
+k 249F 28 64 29
+k 24A0 28 65 29
+k 24A1 28 66 29
+k 24A2 28 67 29
+k 24A3 28 68 29
+k 24A4 28 69 29
+k 24A5 28 6A 29
+k 24A6 28 6B 29
+k 24A7 28 6C 29
+k 24A8 28 6D 29
+k 24A9 28 6E 29
+k 24AA 28 6F 29
+k 24AB 28 70 29
+k 24AC 28 71 29
+k 24AD 28 72 29
+k 24AE 28 73 29
+k 24AF 28 74 29
+k 24B0 28 75 29
+k 24B1 28 76 29
+k 24B2 28 77 29
+k 24B3 28 78 29
+k 24B4 28 79 29
+k 24B5 28 7A 29
+k 24B6 41
+k 24B7 42
+k 24B8 43
+k 24B9 44
+k 24BA 45
+k 24BB 46
+k 24BC 47
+k 24BD 48
+k 24BE 49
+k 24BF 4A
+k 24C0 4B
+k 24C1 4C
+k 24C2 4D
+k 24C3 4E
+k 24C4 4F
+k 24C5 50
+k 24C6 51
+k 24C7 52
+k 24C8 53
+k 24C9 54
+k 24CA 55
+k 24CB 56
+k 24CC 57
+k 24CD 58
+k 24CE 59
+k 24CF 5A
+k 24D0 61
+k 24D1 62
+k 24D2 63
+k 24D3 64
+k 24D4 65
+k 24D5 66
+k 24D6 67
+k 24D7 68
+k 24D8 69
+k 24D9 6A
+k 24DA 6B
+k 24DB 6C
+k 24DC 6D
+k 24DD 6E
+k 24DE 6F
+k 24DF 70
+k 24E0 71
+k 24E1 72
+k 24E2 73
+k 24E3 74
+k 24E4 75
+k 24E5 76
+k 24E6 77
+k 24E7 78
+k 24E8 79
+k 24E9 7A
+k 24EA 30
+k 2A0C 222B 222B 222B 222B
+k 2A74 3A 3A 3D
+k 2A75 3D 3D
+k 2A76 3D 3D 3D
+k 2C7C 6A
+k 2C7D 56
+k 2D6F 2D61
+k 2E9F 6BCD
+k 2EF3 9F9F
+k 2F00 4E00
+k 2F01 4E28
+k 2F02 4E36
+k 2F03 4E3F
+k 2F04 4E59
+k 2F05 4E85
+k 2F06 4E8C
+k 2F07 4EA0
+k 2F08 4EBA
+k 2F09 513F
+k 2F0A 5165
+k 2F0B 516B
+k 2F0C 5182
+k 2F0D 5196
+k 2F0E 51AB
+k 2F0F 51E0
+k 2F10 51F5
+k 2F11 5200
+k 2F12 529B
+k 2F13 52F9
+k 2F14 5315
+k 2F15 531A
+k 2F16 5338
+k 2F17 5341
+k 2F18 535C
+k 2F19 5369
+k 2F1A 5382
+k 2F1B 53B6
+k 2F1C 53C8
+k 2F1D 53E3
+k 2F1E 56D7
+k 2F1F 571F
+k 2F20 58EB
+k 2F21 5902
+k 2F22 590A
+k 2F23 5915
+k 2F24 5927
+k 2F25 5973
+k 2F26 5B50
+k 2F27 5B80
+k 2F28 5BF8
+k 2F29 5C0F
+k 2F2A 5C22
+k 2F2B 5C38
+k 2F2C 5C6E
+k 2F2D 5C71
+k 2F2E 5DDB
+k 2F2F 5DE5
+k 2F30 5DF1
+k 2F31 5DFE
+k 2F32 5E72
+k 2F33 5E7A
+k 2F34 5E7F
+k 2F35 5EF4
+k 2F36 5EFE
+k 2F37 5F0B
+k 2F38 5F13
+k 2F39 5F50
+k 2F3A 5F61
+k 2F3B 5F73
+k 2F3C 5FC3
+k 2F3D 6208
+k 2F3E 6236
+k 2F3F 624B
+k 2F40 652F
+k 2F41 6534
+k 2F42 6587
+k 2F43 6597
+k 2F44 65A4
+k 2F45 65B9
+k 2F46 65E0
+k 2F47 65E5
+k 2F48 66F0
+k 2F49 6708
+k 2F4A 6728
+k 2F4B 6B20
+k 2F4C 6B62
+k 2F4D 6B79
+k 2F4E 6BB3
+k 2F4F 6BCB
+k 2F50 6BD4
+k 2F51 6BDB
+k 2F52 6C0F
+k 2F53 6C14
+k 2F54 6C34
+k 2F55 706B
+k 2F56 722A
+k 2F57 7236
+k 2F58 723B
+k 2F59 723F
+k 2F5A 7247
+k 2F5B 7259
+k 2F5C 725B
+k 2F5D 72AC
+k 2F5E 7384
+k 2F5F 7389
+k 2F60 74DC
+k 2F61 74E6
+k 2F62 7518
+k 2F63 751F
+k 2F64 7528
+k 2F65 7530
+k 2F66 758B
+k 2F67 7592
+k 2F68 7676
+k 2F69 767D
+k 2F6A 76AE
+k 2F6B 76BF
+k 2F6C 76EE
+k 2F6D 77DB
+k 2F6E 77E2
+k 2F6F 77F3
+k 2F70 793A
+k 2F71 79B8
+k 2F72 79BE
+k 2F73 7A74
+k 2F74 7ACB
+k 2F75 7AF9
+k 2F76 7C73
+k 2F77 7CF8
+k 2F78 7F36
+k 2F79 7F51
+k 2F7A 7F8A
+k 2F7B 7FBD
+k 2F7C 8001
+k 2F7D 800C
+k 2F7E 8012
+k 2F7F 8033
+k 2F80 807F
+k 2F81 8089
+k 2F82 81E3
+k 2F83 81EA
+k 2F84 81F3
+k 2F85 81FC
+k 2F86 820C
+k 2F87 821B
+k 2F88 821F
+k 2F89 826E
+k 2F8A 8272
+k 2F8B 8278
+k 2F8C 864D
+k 2F8D 866B
+k 2F8E 8840
+k 2F8F 884C
+k 2F90 8863
+k 2F91 897E
+k 2F92 898B
+k 2F93 89D2
+k 2F94 8A00
+k 2F95 8C37
+k 2F96 8C46
+k 2F97 8C55
+k 2F98 8C78
+k 2F99 8C9D
+k 2F9A 8D64
+k 2F9B 8D70
+k 2F9C 8DB3
+k 2F9D 8EAB
+k 2F9E 8ECA
+k 2F9F 8F9B
+k 2FA0 8FB0
+k 2FA1 8FB5
+k 2FA2 9091
+k 2FA3 9149
+k 2FA4 91C6
+k 2FA5 91CC
+k 2FA6 91D1
+k 2FA7 9577
+k 2FA8 9580
+k 2FA9 961C
+k 2FAA 96B6
+k 2FAB 96B9
+k 2FAC 96E8
+k 2FAD 9751
+k 2FAE 975E
+k 2FAF 9762
+k 2FB0 9769
+k 2FB1 97CB
+k 2FB2 97ED
+k 2FB3 97F3
+k 2FB4 9801
+k 2FB5 98A8
+k 2FB6 98DB
+k 2FB7 98DF
+k 2FB8 9996
+k 2FB9 9999
+k 2FBA 99AC
+k 2FBB 9AA8
+k 2FBC 9AD8
+k 2FBD 9ADF
+k 2FBE 9B25
+k 2FBF 9B2F
+k 2FC0 9B32
+k 2FC1 9B3C
+k 2FC2 9B5A
+k 2FC3 9CE5
+k 2FC4 9E75
+k 2FC5 9E7F
+k 2FC6 9EA5
+k 2FC7 9EBB
+k 2FC8 9EC3
+k 2FC9 9ECD
+k 2FCA 9ED1
+k 2FCB 9EF9
+k 2FCC 9EFD
+k 2FCD 9F0E
+k 2FCE 9F13
+k 2FCF 9F20
+k 2FD0 9F3B
+k 2FD1 9F4A
+k 2FD2 9F52
+k 2FD3 9F8D
+k 2FD4 9F9C
+k 2FD5 9FA0
+k 3000 20
+k 3036 3012
+k 3038 5341
+k 3039 5344
+k 303A 5345
+k 309B 20 3099
+k 309C 20 309A
+k 309F 3088 308A
+k 30FF 30B3 30C8
+k 3131 1100
+k 3132 1101
+k 3133 11AA
+k 3134 1102
+k 3135 11AC
+k 3136 11AD
+k 3137 1103
+k 3138 1104
+k 3139 1105
+k 313A 11B0
+k 313B 11B1
+k 313C 11B2
+k 313D 11B3
+k 313E 11B4
+k 313F 11B5
+k 3140 111A
+k 3141 1106
+k 3142 1107
+k 3143 1108
+k 3144 1121
+k 3145 1109
+k 3146 110A
+k 3147 110B
+k 3148 110C
+k 3149 110D
+k 314A 110E
+k 314B 110F
+k 314C 1110
+k 314D 1111
+k 314E 1112
+k 314F 1161
+k 3150 1162
+k 3151 1163
+k 3152 1164
+k 3153 1165
+k 3154 1166
+k 3155 1167
+k 3156 1168
+k 3157 1169
+k 3158 116A
+k 3159 116B
+k 315A 116C
+k 315B 116D
+k 315C 116E
+k 315D 116F
+k 315E 1170
+k 315F 1171
+k 3160 1172
+k 3161 1173
+k 3162 1174
+k 3163 1175
+k 3164 1160
+k 3165 1114
+k 3166 1115
+k 3167 11C7
+k 3168 11C8
+k 3169 11CC
+k 316A 11CE
+k 316B 11D3
+k 316C 11D7
+k 316D 11D9
+k 316E 111C
+k 316F 11DD
+k 3170 11DF
+k 3171 111D
+k 3172 111E
+k 3173 1120
+k 3174 1122
+k 3175 1123
+k 3176 1127
+k 3177 1129
+k 3178 112B
+k 3179 112C
+k 317A 112D
+k 317B 112E
+k 317C 112F
+k 317D 1132
+k 317E 1136
+k 317F 1140
+k 3180 1147
+k 3181 114C
+k 3182 11F1
+k 3183 11F2
+k 3184 1157
+k 3185 1158
+k 3186 1159
+k 3187 1184
+k 3188 1185
+k 3189 1188
+k 318A 1191
+k 318B 1192
+k 318C 1194
+k 318D 119E
+k 318E 11A1
+k 3192 4E00
+k 3193 4E8C
+k 3194 4E09
+k 3195 56DB
+k 3196 4E0A
+k 3197 4E2D
+k 3198 4E0B
+k 3199 7532
+k 319A 4E59
+k 319B 4E19
+k 319C 4E01
+k 319D 5929
+k 319E 5730
+k 319F 4EBA
+k 3200 28 1100 29
+k 3201 28 1102 29
+k 3202 28 1103 29
+k 3203 28 1105 29
+k 3204 28 1106 29
+k 3205 28 1107 29
+k 3206 28 1109 29
+k 3207 28 110B 29
+k 3208 28 110C 29
+k 3209 28 110E 29
+k 320A 28 110F 29
+k 320B 28 1110 29
+k 320C 28 1111 29
+k 320D 28 1112 29
+k 320E 28 1100 1161 29
+k 320F 28 1102 1161 29
+k 3210 28 1103 1161 29
+k 3211 28 1105 1161 29
+k 3212 28 1106 1161 29
+k 3213 28 1107 1161 29
+k 3214 28 1109 1161 29
+k 3215 28 110B 1161 29
+k 3216 28 110C 1161 29
+k 3217 28 110E 1161 29
+k 3218 28 110F 1161 29
+k 3219 28 1110 1161 29
+k 321A 28 1111 1161 29
+k 321B 28 1112 1161 29
+k 321C 28 110C 116E 29
+k 321D 28 110B 1169 110C 1165 11AB 29
+k 321E 28 110B 1169 1112 116E 29
+k 3220 28 4E00 29
+k 3221 28 4E8C 29
+k 3222 28 4E09 29
+k 3223 28 56DB 29
+k 3224 28 4E94 29
+k 3225 28 516D 29
+k 3226 28 4E03 29
+k 3227 28 516B 29
+k 3228 28 4E5D 29
+k 3229 28 5341 29
+k 322A 28 6708 29
+k 322B 28 706B 29
+k 322C 28 6C34 29
+k 322D 28 6728 29
+k 322E 28 91D1 29
+k 322F 28 571F 29
+k 3230 28 65E5 29
+k 3231 28 682A 29
+k 3232 28 6709 29
+k 3233 28 793E 29
+k 3234 28 540D 29
+k 3235 28 7279 29
+k 3236 28 8CA1 29
+k 3237 28 795D 29
+k 3238 28 52B4 29
+k 3239 28 4EE3 29
+k 323A 28 547C 29
+k 323B 28 5B66 29
+k 323C 28 76E3 29
+k 323D 28 4F01 29
+k 323E 28 8CC7 29
+k 323F 28 5354 29
+k 3240 28 796D 29
+k 3241 28 4F11 29
+k 3242 28 81EA 29
+k 3243 28 81F3 29
+k 3244 554F
+k 3245 5E7C
+k 3246 6587
+k 3247 7B8F
+k 3250 50 54 45
+k 3251 32 31
+k 3252 32 32
+k 3253 32 33
+k 3254 32 34
+k 3255 32 35
+k 3256 32 36
+k 3257 32 37
+k 3258 32 38
+k 3259 32 39
+k 325A 33 30
+k 325B 33 31
+k 325C 33 32
+k 325D 33 33
+k 325E 33 34
+k 325F 33 35
+k 3260 1100
+k 3261 1102
+k 3262 1103
+k 3263 1105
+k 3264 1106
+k 3265 1107
+k 3266 1109
+k 3267 110B
+k 3268 110C
+k 3269 110E
+k 326A 110F
+k 326B 1110
+k 326C 1111
+k 326D 1112
+k 326E 1100 1161
+k 326F 1102 1161
+k 3270 1103 1161
+k 3271 1105 1161
+k 3272 1106 1161
+k 3273 1107 1161
+k 3274 1109 1161
+k 3275 110B 1161
+k 3276 110C 1161
+k 3277 110E 1161
+k 3278 110F 1161
+k 3279 1110 1161
+k 327A 1111 1161
+k 327B 1112 1161
+k 327C 110E 1161 11B7 1100 1169
+k 327D 110C 116E 110B 1174
+k 327E 110B 116E
+k 3280 4E00
+k 3281 4E8C
+k 3282 4E09
+k 3283 56DB
+k 3284 4E94
+k 3285 516D
+k 3286 4E03
+k 3287 516B
+k 3288 4E5D
+k 3289 5341
+k 328A 6708
+k 328B 706B
+k 328C 6C34
+k 328D 6728
+k 328E 91D1
+k 328F 571F
+k 3290 65E5
+k 3291 682A
+k 3292 6709
+k 3293 793E
+k 3294 540D
+k 3295 7279
+k 3296 8CA1
+k 3297 795D
+k 3298 52B4
+k 3299 79D8
+k 329A 7537
+k 329B 5973
+k 329C 9069
+k 329D 512A
+k 329E 5370
+k 329F 6CE8
+k 32A0 9805
+k 32A1 4F11
+k 32A2 5199
+k 32A3 6B63
+k 32A4 4E0A
+k 32A5 4E2D
+k 32A6 4E0B
+k 32A7 5DE6
+k 32A8 53F3
+k 32A9 533B
+k 32AA 5B97
+k 32AB 5B66
+k 32AC 76E3
+k 32AD 4F01
+k 32AE 8CC7
+k 32AF 5354
+k 32B0 591C
+k 32B1 33 36
+k 32B2 33 37
+k 32B3 33 38
+k 32B4 33 39
+k 32B5 34 30
+k 32B6 34 31
+k 32B7 34 32
+k 32B8 34 33
+k 32B9 34 34
+k 32BA 34 35
+k 32BB 34 36
+k 32BC 34 37
+k 32BD 34 38
+k 32BE 34 39
+k 32BF 35 30
+k 32C0 31 6708
+k 32C1 32 6708
+k 32C2 33 6708
+k 32C3 34 6708
+k 32C4 35 6708
+k 32C5 36 6708
+k 32C6 37 6708
+k 32C7 38 6708
+k 32C8 39 6708
+k 32C9 31 30 6708
+k 32CA 31 31 6708
+k 32CB 31 32 6708
+k 32CC 48 67
+k 32CD 65 72 67
+k 32CE 65 56
+k 32CF 4C 54 44
+k 32D0 30A2
+k 32D1 30A4
+k 32D2 30A6
+k 32D3 30A8
+k 32D4 30AA
+k 32D5 30AB
+k 32D6 30AD
+k 32D7 30AF
+k 32D8 30B1
+k 32D9 30B3
+k 32DA 30B5
+k 32DB 30B7
+k 32DC 30B9
+k 32DD 30BB
+k 32DE 30BD
+k 32DF 30BF
+k 32E0 30C1
+k 32E1 30C4
+k 32E2 30C6
+k 32E3 30C8
+k 32E4 30CA
+k 32E5 30CB
+k 32E6 30CC
+k 32E7 30CD
+k 32E8 30CE
+k 32E9 30CF
+k 32EA 30D2
+k 32EB 30D5
+k 32EC 30D8
+k 32ED 30DB
+k 32EE 30DE
+k 32EF 30DF
+k 32F0 30E0
+k 32F1 30E1
+k 32F2 30E2
+k 32F3 30E4
+k 32F4 30E6
+k 32F5 30E8
+k 32F6 30E9
+k 32F7 30EA
+k 32F8 30EB
+k 32F9 30EC
+k 32FA 30ED
+k 32FB 30EF
+k 32FC 30F0
+k 32FD 30F1
+k 32FE 30F2
+k 32FF 4EE4 548C
+k 3300 30A2 30CF 309A 30FC 30C8
+k 3301 30A2 30EB 30D5 30A1
+k 3302 30A2 30F3 30D8 309A 30A2
+k 3303 30A2 30FC 30EB
+k 3304 30A4 30CB 30F3 30AF 3099
+k 3305 30A4 30F3 30C1
+k 3306 30A6 30A9 30F3
+k 3307 30A8 30B9 30AF 30FC 30C8 3099
+k 3308 30A8 30FC 30AB 30FC
+k 3309 30AA 30F3 30B9
+k 330A 30AA 30FC 30E0
+k 330B 30AB 30A4 30EA
+k 330C 30AB 30E9 30C3 30C8
+k 330D 30AB 30ED 30EA 30FC
+k 330E 30AB 3099 30ED 30F3
+k 330F 30AB 3099 30F3 30DE
+k 3310 30AD 3099 30AB 3099
+k 3311 30AD 3099 30CB 30FC
+k 3312 30AD 30E5 30EA 30FC
+k 3313 30AD 3099 30EB 30BF 3099 30FC
+k 3314 30AD 30ED
+k 3315 30AD 30ED 30AF 3099 30E9 30E0
+k 3316 30AD 30ED 30E1 30FC 30C8 30EB
+k 3317 30AD 30ED 30EF 30C3 30C8
+k 3318 30AF 3099 30E9 30E0
+k 3319 30AF 3099 30E9 30E0 30C8 30F3
+k 331A 30AF 30EB 30BB 3099 30A4 30ED
+k 331B 30AF 30ED 30FC 30CD
+k 331C 30B1 30FC 30B9
+k 331D 30B3 30EB 30CA
+k 331E 30B3 30FC 30DB 309A
+k 331F 30B5 30A4 30AF 30EB
+k 3320 30B5 30F3 30C1 30FC 30E0
+k 3321 30B7 30EA 30F3 30AF 3099
+k 3322 30BB 30F3 30C1
+k 3323 30BB 30F3 30C8
+k 3324 30BF 3099 30FC 30B9
+k 3325 30C6 3099 30B7
+k 3326 30C8 3099 30EB
+k 3327 30C8 30F3
+k 3328 30CA 30CE
+k 3329 30CE 30C3 30C8
+k 332A 30CF 30A4 30C4
+k 332B 30CF 309A 30FC 30BB 30F3 30C8
+k 332C 30CF 309A 30FC 30C4
+k 332D 30CF 3099 30FC 30EC 30EB
+k 332E 30D2 309A 30A2 30B9 30C8 30EB
+k 332F 30D2 309A 30AF 30EB
+k 3330 30D2 309A 30B3
+k 3331 30D2 3099 30EB
+k 3332 30D5 30A1 30E9 30C3 30C8 3099
+k 3333 30D5 30A3 30FC 30C8
+k 3334 30D5 3099 30C3 30B7 30A7 30EB
+k 3335 30D5 30E9 30F3
+k 3336 30D8 30AF 30BF 30FC 30EB
+k 3337 30D8 309A 30BD
+k 3338 30D8 309A 30CB 30D2
+k 3339 30D8 30EB 30C4
+k 333A 30D8 309A 30F3 30B9
+k 333B 30D8 309A 30FC 30B7 3099
+k 333C 30D8 3099 30FC 30BF
+k 333D 30DB 309A 30A4 30F3 30C8
+k 333E 30DB 3099 30EB 30C8
+k 333F 30DB 30F3
+k 3340 30DB 309A 30F3 30C8 3099
+k 3341 30DB 30FC 30EB
+k 3342 30DB 30FC 30F3
+k 3343 30DE 30A4 30AF 30ED
+k 3344 30DE 30A4 30EB
+k 3345 30DE 30C3 30CF
+k 3346 30DE 30EB 30AF
+k 3347 30DE 30F3 30B7 30E7 30F3
+k 3348 30DF 30AF 30ED 30F3
+k 3349 30DF 30EA
+k 334A 30DF 30EA 30CF 3099 30FC 30EB
+k 334B 30E1 30AB 3099
+k 334C 30E1 30AB 3099 30C8 30F3
+k 334D 30E1 30FC 30C8 30EB
+k 334E 30E4 30FC 30C8 3099
+k 334F 30E4 30FC 30EB
+k 3350 30E6 30A2 30F3
+k 3351 30EA 30C3 30C8 30EB
+k 3352 30EA 30E9
+k 3353 30EB 30D2 309A 30FC
+k 3354 30EB 30FC 30D5 3099 30EB
+k 3355 30EC 30E0
+k 3356 30EC 30F3 30C8 30B1 3099 30F3
+k 3357 30EF 30C3 30C8
+k 3358 30 70B9
+k 3359 31 70B9
+k 335A 32 70B9
+k 335B 33 70B9
+k 335C 34 70B9
+k 335D 35 70B9
+k 335E 36 70B9
+k 335F 37 70B9
+k 3360 38 70B9
+k 3361 39 70B9
+k 3362 31 30 70B9
+k 3363 31 31 70B9
+k 3364 31 32 70B9
+k 3365 31 33 70B9
+k 3366 31 34 70B9
+k 3367 31 35 70B9
+k 3368 31 36 70B9
+k 3369 31 37 70B9
+k 336A 31 38 70B9
+k 336B 31 39 70B9
+k 336C 32 30 70B9
+k 336D 32 31 70B9
+k 336E 32 32 70B9
+k 336F 32 33 70B9
+k 3370 32 34 70B9
+k 3371 68 50 61
+k 3372 64 61
+k 3373 41 55
+k 3374 62 61 72
+k 3375 6F 56
+k 3376 70 63
+k 3377 64 6D
+k 3378 64 6D 32
+k 3379 64 6D 33
+k 337A 49 55
+k 337B 5E73 6210
+k 337C 662D 548C
+k 337D 5927 6B63
+k 337E 660E 6CBB
+k 337F 682A 5F0F 4F1A 793E
+k 3380 70 41
+k 3381 6E 41
+k 3382 3BC 41
+k 3383 6D 41
+k 3384 6B 41
+k 3385 4B 42
+k 3386 4D 42
+k 3387 47 42
+k 3388 63 61 6C
+k 3389 6B 63 61 6C
+k 338A 70 46
+k 338B 6E 46
+k 338C 3BC 46
+k 338D 3BC 67
+k 338E 6D 67
+k 338F 6B 67
+k 3390 48 7A
+k 3391 6B 48 7A
+k 3392 4D 48 7A
+k 3393 47 48 7A
+k 3394 54 48 7A
+k 3395 3BC 6C
+k 3396 6D 6C
+k 3397 64 6C
+k 3398 6B 6C
+k 3399 66 6D
+k 339A 6E 6D
+k 339B 3BC 6D
+k 339C 6D 6D
+k 339D 63 6D
+k 339E 6B 6D
+k 339F 6D 6D 32
+k 33A0 63 6D 32
+k 33A1 6D 32
+k 33A2 6B 6D 32
+k 33A3 6D 6D 33
+k 33A4 63 6D 33
+k 33A5 6D 33
+k 33A6 6B 6D 33
+k 33A7 6D 2215 73
+k 33A8 6D 2215 73 32
+k 33A9 50 61
+k 33AA 6B 50 61
+k 33AB 4D 50 61
+k 33AC 47 50 61
+k 33AD 72 61 64
+k 33AE 72 61 64 2215 73
+k 33AF 72 61 64 2215 73 32
+k 33B0 70 73
+k 33B1 6E 73
+k 33B2 3BC 73
+k 33B3 6D 73
+k 33B4 70 56
+k 33B5 6E 56
+k 33B6 3BC 56
+k 33B7 6D 56
+k 33B8 6B 56
+k 33B9 4D 56
+k 33BA 70 57
+k 33BB 6E 57
+k 33BC 3BC 57
+k 33BD 6D 57
+k 33BE 6B 57
+k 33BF 4D 57
+k 33C0 6B 3A9
+k 33C1 4D 3A9
+k 33C2 61 2E 6D 2E
+k 33C3 42 71
+k 33C4 63 63
+k 33C5 63 64
+k 33C6 43 2215 6B 67
+k 33C7 43 6F 2E
+k 33C8 64 42
+k 33C9 47 79
+k 33CA 68 61
+k 33CB 48 50
+k 33CC 69 6E
+k 33CD 4B 4B
+k 33CE 4B 4D
+k 33CF 6B 74
+k 33D0 6C 6D
+k 33D1 6C 6E
+k 33D2 6C 6F 67
+k 33D3 6C 78
+k 33D4 6D 62
+k 33D5 6D 69 6C
+k 33D6 6D 6F 6C
+k 33D7 50 48
+k 33D8 70 2E 6D 2E
+k 33D9 50 50 4D
+k 33DA 50 52
+k 33DB 73 72
+k 33DC 53 76
+k 33DD 57 62
+k 33DE 56 2215 6D
+k 33DF 41 2215 6D
+k 33E0 31 65E5
+k 33E1 32 65E5
+k 33E2 33 65E5
+k 33E3 34 65E5
+k 33E4 35 65E5
+k 33E5 36 65E5
+k 33E6 37 65E5
+k 33E7 38 65E5
+k 33E8 39 65E5
+k 33E9 31 30 65E5
+k 33EA 31 31 65E5
+k 33EB 31 32 65E5
+k 33EC 31 33 65E5
+k 33ED 31 34 65E5
+k 33EE 31 35 65E5
+k 33EF 31 36 65E5
+k 33F0 31 37 65E5
+k 33F1 31 38 65E5
+k 33F2 31 39 65E5
+k 33F3 32 30 65E5
+k 33F4 32 31 65E5
+k 33F5 32 32 65E5
+k 33F6 32 33 65E5
+k 33F7 32 34 65E5
+k 33F8 32 35 65E5
+k 33F9 32 36 65E5
+k 33FA 32 37 65E5
+k 33FB 32 38 65E5
+k 33FC 32 39 65E5
+k 33FD 33 30 65E5
+k 33FE 33 31 65E5
+k 33FF 67 61 6C
+k A69C 44A
+k A69D 44C
+k A770 A76F
+k A7F2 43
+k A7F3 46
+k A7F4 51
+k A7F8 126
+k A7F9 153
+k AB5C A727
+k AB5D AB37
+k AB5E 26B
+k AB5F AB52
+k AB69 28D
+k FB00 66 66
+k FB01 66 69
+k FB02 66 6C
+k FB03 66 66 69
+k FB04 66 66 6C
+k FB05 73 74
+k FB06 73 74
+k FB13 574 576
+k FB14 574 565
+k FB15 574 56B
+k FB16 57E 576
+k FB17 574 56D
+k FB20 5E2
+k FB21 5D0
+k FB22 5D3
+k FB23 5D4
+k FB24 5DB
+k FB25 5DC
+k FB26 5DD
+k FB27 5E8
+k FB28 5EA
+k FB29 2B
+k FB4F 5D0 5DC
+k FB50 671
+k FB51 671
+k FB52 67B
+k FB53 67B
+k FB54 67B
+k FB55 67B
+k FB56 67E
+k FB57 67E
+k FB58 67E
+k FB59 67E
+k FB5A 680
+k FB5B 680
+k FB5C 680
+k FB5D 680
+k FB5E 67A
+k FB5F 67A
+k FB60 67A
+k FB61 67A
+k FB62 67F
+k FB63 67F
+k FB64 67F
+k FB65 67F
+k FB66 679
+k FB67 679
+k FB68 679
+k FB69 679
+k FB6A 6A4
+k FB6B 6A4
+k FB6C 6A4
+k FB6D 6A4
+k FB6E 6A6
+k FB6F 6A6
+k FB70 6A6
+k FB71 6A6
+k FB72 684
+k FB73 684
+k FB74 684
+k FB75 684
+k FB76 683
+k FB77 683
+k FB78 683
+k FB79 683
+k FB7A 686
+k FB7B 686
+k FB7C 686
+k FB7D 686
+k FB7E 687
+k FB7F 687
+k FB80 687
+k FB81 687
+k FB82 68D
+k FB83 68D
+k FB84 68C
+k FB85 68C
+k FB86 68E
+k FB87 68E
+k FB88 688
+k FB89 688
+k FB8A 698
+k FB8B 698
+k FB8C 691
+k FB8D 691
+k FB8E 6A9
+k FB8F 6A9
+k FB90 6A9
+k FB91 6A9
+k FB92 6AF
+k FB93 6AF
+k FB94 6AF
+k FB95 6AF
+k FB96 6B3
+k FB97 6B3
+k FB98 6B3
+k FB99 6B3
+k FB9A 6B1
+k FB9B 6B1
+k FB9C 6B1
+k FB9D 6B1
+k FB9E 6BA
+k FB9F 6BA
+k FBA0 6BB
+k FBA1 6BB
+k FBA2 6BB
+k FBA3 6BB
+k FBA4 6D5 654
+k FBA5 6D5 654
+k FBA6 6C1
+k FBA7 6C1
+k FBA8 6C1
+k FBA9 6C1
+k FBAA 6BE
+k FBAB 6BE
+k FBAC 6BE
+k FBAD 6BE
+k FBAE 6D2
+k FBAF 6D2
+k FBB0 6D2 654
+k FBB1 6D2 654
+k FBD3 6AD
+k FBD4 6AD
+k FBD5 6AD
+k FBD6 6AD
+k FBD7 6C7
+k FBD8 6C7
+k FBD9 6C6
+k FBDA 6C6
+k FBDB 6C8
+k FBDC 6C8
+k FBDD 6C7 674
+k FBDE 6CB
+k FBDF 6CB
+k FBE0 6C5
+k FBE1 6C5
+k FBE2 6C9
+k FBE3 6C9
+k FBE4 6D0
+k FBE5 6D0
+k FBE6 6D0
+k FBE7 6D0
+k FBE8 649
+k FBE9 649
+k FBEA 64A 654 627
+k FBEB 64A 654 627
+k FBEC 64A 654 6D5
+k FBED 64A 654 6D5
+k FBEE 64A 654 648
+k FBEF 64A 654 648
+k FBF0 64A 654 6C7
+k FBF1 64A 654 6C7
+k FBF2 64A 654 6C6
+k FBF3 64A 654 6C6
+k FBF4 64A 654 6C8
+k FBF5 64A 654 6C8
+k FBF6 64A 654 6D0
+k FBF7 64A 654 6D0
+k FBF8 64A 654 6D0
+k FBF9 64A 654 649
+k FBFA 64A 654 649
+k FBFB 64A 654 649
+k FBFC 6CC
+k FBFD 6CC
+k FBFE 6CC
+k FBFF 6CC
+k FC00 64A 654 62C
+k FC01 64A 654 62D
+k FC02 64A 654 645
+k FC03 64A 654 649
+k FC04 64A 654 64A
+k FC05 628 62C
+k FC06 628 62D
+k FC07 628 62E
+k FC08 628 645
+k FC09 628 649
+k FC0A 628 64A
+k FC0B 62A 62C
+k FC0C 62A 62D
+k FC0D 62A 62E
+k FC0E 62A 645
+k FC0F 62A 649
+k FC10 62A 64A
+k FC11 62B 62C
+k FC12 62B 645
+k FC13 62B 649
+k FC14 62B 64A
+k FC15 62C 62D
+k FC16 62C 645
+k FC17 62D 62C
+k FC18 62D 645
+k FC19 62E 62C
+k FC1A 62E 62D
+k FC1B 62E 645
+k FC1C 633 62C
+k FC1D 633 62D
+k FC1E 633 62E
+k FC1F 633 645
+k FC20 635 62D
+k FC21 635 645
+k FC22 636 62C
+k FC23 636 62D
+k FC24 636 62E
+k FC25 636 645
+k FC26 637 62D
+k FC27 637 645
+k FC28 638 645
+k FC29 639 62C
+k FC2A 639 645
+k FC2B 63A 62C
+k FC2C 63A 645
+k FC2D 641 62C
+k FC2E 641 62D
+k FC2F 641 62E
+k FC30 641 645
+k FC31 641 649
+k FC32 641 64A
+k FC33 642 62D
+k FC34 642 645
+k FC35 642 649
+k FC36 642 64A
+k FC37 643 627
+k FC38 643 62C
+k FC39 643 62D
+k FC3A 643 62E
+k FC3B 643 644
+k FC3C 643 645
+k FC3D 643 649
+k FC3E 643 64A
+k FC3F 644 62C
+k FC40 644 62D
+k FC41 644 62E
+k FC42 644 645
+k FC43 644 649
+k FC44 644 64A
+k FC45 645 62C
+k FC46 645 62D
+k FC47 645 62E
+k FC48 645 645
+k FC49 645 649
+k FC4A 645 64A
+k FC4B 646 62C
+k FC4C 646 62D
+k FC4D 646 62E
+k FC4E 646 645
+k FC4F 646 649
+k FC50 646 64A
+k FC51 647 62C
+k FC52 647 645
+k FC53 647 649
+k FC54 647 64A
+k FC55 64A 62C
+k FC56 64A 62D
+k FC57 64A 62E
+k FC58 64A 645
+k FC59 64A 649
+k FC5A 64A 64A
+k FC5B 630 670
+k FC5C 631 670
+k FC5D 649 670
+k FC5E 20 64C 651
+k FC5F 20 64D 651
+k FC60 20 64E 651
+k FC61 20 64F 651
+k FC62 20 650 651
+k FC63 20 651 670
+k FC64 64A 654 631
+k FC65 64A 654 632
+k FC66 64A 654 645
+k FC67 64A 654 646
+k FC68 64A 654 649
+k FC69 64A 654 64A
+k FC6A 628 631
+k FC6B 628 632
+k FC6C 628 645
+k FC6D 628 646
+k FC6E 628 649
+k FC6F 628 64A
+k FC70 62A 631
+k FC71 62A 632
+k FC72 62A 645
+k FC73 62A 646
+k FC74 62A 649
+k FC75 62A 64A
+k FC76 62B 631
+k FC77 62B 632
+k FC78 62B 645
+k FC79 62B 646
+k FC7A 62B 649
+k FC7B 62B 64A
+k FC7C 641 649
+k FC7D 641 64A
+k FC7E 642 649
+k FC7F 642 64A
+k FC80 643 627
+k FC81 643 644
+k FC82 643 645
+k FC83 643 649
+k FC84 643 64A
+k FC85 644 645
+k FC86 644 649
+k FC87 644 64A
+k FC88 645 627
+k FC89 645 645
+k FC8A 646 631
+k FC8B 646 632
+k FC8C 646 645
+k FC8D 646 646
+k FC8E 646 649
+k FC8F 646 64A
+k FC90 649 670
+k FC91 64A 631
+k FC92 64A 632
+k FC93 64A 645
+k FC94 64A 646
+k FC95 64A 649
+k FC96 64A 64A
+k FC97 64A 654 62C
+k FC98 64A 654 62D
+k FC99 64A 654 62E
+k FC9A 64A 654 645
+k FC9B 64A 654 647
+k FC9C 628 62C
+k FC9D 628 62D
+k FC9E 628 62E
+k FC9F 628 645
+k FCA0 628 647
+k FCA1 62A 62C
+k FCA2 62A 62D
+k FCA3 62A 62E
+k FCA4 62A 645
+k FCA5 62A 647
+k FCA6 62B 645
+k FCA7 62C 62D
+k FCA8 62C 645
+k FCA9 62D 62C
+k FCAA 62D 645
+k FCAB 62E 62C
+k FCAC 62E 645
+k FCAD 633 62C
+k FCAE 633 62D
+k FCAF 633 62E
+k FCB0 633 645
+k FCB1 635 62D
+k FCB2 635 62E
+k FCB3 635 645
+k FCB4 636 62C
+k FCB5 636 62D
+k FCB6 636 62E
+k FCB7 636 645
+k FCB8 637 62D
+k FCB9 638 645
+k FCBA 639 62C
+k FCBB 639 645
+k FCBC 63A 62C
+k FCBD 63A 645
+k FCBE 641 62C
+k FCBF 641 62D
+k FCC0 641 62E
+k FCC1 641 645
+k FCC2 642 62D
+k FCC3 642 645
+k FCC4 643 62C
+k FCC5 643 62D
+k FCC6 643 62E
+k FCC7 643 644
+k FCC8 643 645
+k FCC9 644 62C
+k FCCA 644 62D
+k FCCB 644 62E
+k FCCC 644 645
+k FCCD 644 647
+k FCCE 645 62C
+k FCCF 645 62D
+k FCD0 645 62E
+k FCD1 645 645
+k FCD2 646 62C
+k FCD3 646 62D
+k FCD4 646 62E
+k FCD5 646 645
+k FCD6 646 647
+k FCD7 647 62C
+k FCD8 647 645
+k FCD9 647 670
+k FCDA 64A 62C
+k FCDB 64A 62D
+k FCDC 64A 62E
+k FCDD 64A 645
+k FCDE 64A 647
+k FCDF 64A 654 645
+k FCE0 64A 654 647
+k FCE1 628 645
+k FCE2 628 647
+k FCE3 62A 645
+k FCE4 62A 647
+k FCE5 62B 645
+k FCE6 62B 647
+k FCE7 633 645
+k FCE8 633 647
+k FCE9 634 645
+k FCEA 634 647
+k FCEB 643 644
+k FCEC 643 645
+k FCED 644 645
+k FCEE 646 645
+k FCEF 646 647
+k FCF0 64A 645
+k FCF1 64A 647
+k FCF2 640 64E 651
+k FCF3 640 64F 651
+k FCF4 640 650 651
+k FCF5 637 649
+k FCF6 637 64A
+k FCF7 639 649
+k FCF8 639 64A
+k FCF9 63A 649
+k FCFA 63A 64A
+k FCFB 633 649
+k FCFC 633 64A
+k FCFD 634 649
+k FCFE 634 64A
+k FCFF 62D 649
+k FD00 62D 64A
+k FD01 62C 649
+k FD02 62C 64A
+k FD03 62E 649
+k FD04 62E 64A
+k FD05 635 649
+k FD06 635 64A
+k FD07 636 649
+k FD08 636 64A
+k FD09 634 62C
+k FD0A 634 62D
+k FD0B 634 62E
+k FD0C 634 645
+k FD0D 634 631
+k FD0E 633 631
+k FD0F 635 631
+k FD10 636 631
+k FD11 637 649
+k FD12 637 64A
+k FD13 639 649
+k FD14 639 64A
+k FD15 63A 649
+k FD16 63A 64A
+k FD17 633 649
+k FD18 633 64A
+k FD19 634 649
+k FD1A 634 64A
+k FD1B 62D 649
+k FD1C 62D 64A
+k FD1D 62C 649
+k FD1E 62C 64A
+k FD1F 62E 649
+k FD20 62E 64A
+k FD21 635 649
+k FD22 635 64A
+k FD23 636 649
+k FD24 636 64A
+k FD25 634 62C
+k FD26 634 62D
+k FD27 634 62E
+k FD28 634 645
+k FD29 634 631
+k FD2A 633 631
+k FD2B 635 631
+k FD2C 636 631
+k FD2D 634 62C
+k FD2E 634 62D
+k FD2F 634 62E
+k FD30 634 645
+k FD31 633 647
+k FD32 634 647
+k FD33 637 645
+k FD34 633 62C
+k FD35 633 62D
+k FD36 633 62E
+k FD37 634 62C
+k FD38 634 62D
+k FD39 634 62E
+k FD3A 637 645
+k FD3B 638 645
+k FD3C 627 64B
+k FD3D 627 64B
+k FD50 62A 62C 645
+k FD51 62A 62D 62C
+k FD52 62A 62D 62C
+k FD53 62A 62D 645
+k FD54 62A 62E 645
+k FD55 62A 645 62C
+k FD56 62A 645 62D
+k FD57 62A 645 62E
+k FD58 62C 645 62D
+k FD59 62C 645 62D
+k FD5A 62D 645 64A
+k FD5B 62D 645 649
+k FD5C 633 62D 62C
+k FD5D 633 62C 62D
+k FD5E 633 62C 649
+k FD5F 633 645 62D
+k FD60 633 645 62D
+k FD61 633 645 62C
+k FD62 633 645 645
+k FD63 633 645 645
+k FD64 635 62D 62D
+k FD65 635 62D 62D
+k FD66 635 645 645
+k FD67 634 62D 645
+k FD68 634 62D 645
+k FD69 634 62C 64A
+k FD6A 634 645 62E
+k FD6B 634 645 62E
+k FD6C 634 645 645
+k FD6D 634 645 645
+k FD6E 636 62D 649
+k FD6F 636 62E 645
+k FD70 636 62E 645
+k FD71 637 645 62D
+k FD72 637 645 62D
+k FD73 637 645 645
+k FD74 637 645 64A
+k FD75 639 62C 645
+k FD76 639 645 645
+k FD77 639 645 645
+k FD78 639 645 649
+k FD79 63A 645 645
+k FD7A 63A 645 64A
+k FD7B 63A 645 649
+k FD7C 641 62E 645
+k FD7D 641 62E 645
+k FD7E 642 645 62D
+k FD7F 642 645 645
+k FD80 644 62D 645
+k FD81 644 62D 64A
+k FD82 644 62D 649
+k FD83 644 62C 62C
+k FD84 644 62C 62C
+k FD85 644 62E 645
+k FD86 644 62E 645
+k FD87 644 645 62D
+k FD88 644 645 62D
+k FD89 645 62D 62C
+k FD8A 645 62D 645
+k FD8B 645 62D 64A
+k FD8C 645 62C 62D
+k FD8D 645 62C 645
+k FD8E 645 62E 62C
+k FD8F 645 62E 645
+k FD92 645 62C 62E
+k FD93 647 645 62C
+k FD94 647 645 645
+k FD95 646 62D 645
+k FD96 646 62D 649
+k FD97 646 62C 645
+k FD98 646 62C 645
+k FD99 646 62C 649
+k FD9A 646 645 64A
+k FD9B 646 645 649
+k FD9C 64A 645 645
+k FD9D 64A 645 645
+k FD9E 628 62E 64A
+k FD9F 62A 62C 64A
+k FDA0 62A 62C 649
+k FDA1 62A 62E 64A
+k FDA2 62A 62E 649
+k FDA3 62A 645 64A
+k FDA4 62A 645 649
+k FDA5 62C 645 64A
+k FDA6 62C 62D 649
+k FDA7 62C 645 649
+k FDA8 633 62E 649
+k FDA9 635 62D 64A
+k FDAA 634 62D 64A
+k FDAB 636 62D 64A
+k FDAC 644 62C 64A
+k FDAD 644 645 64A
+k FDAE 64A 62D 64A
+k FDAF 64A 62C 64A
+k FDB0 64A 645 64A
+k FDB1 645 645 64A
+k FDB2 642 645 64A
+k FDB3 646 62D 64A
+k FDB4 642 645 62D
+k FDB5 644 62D 645
+k FDB6 639 645 64A
+k FDB7 643 645 64A
+k FDB8 646 62C 62D
+k FDB9 645 62E 64A
+k FDBA 644 62C 645
+k FDBB 643 645 645
+k FDBC 644 62C 645
+k FDBD 646 62C 62D
+k FDBE 62C 62D 64A
+k FDBF 62D 62C 64A
+k FDC0 645 62C 64A
+k FDC1 641 645 64A
+k FDC2 628 62D 64A
+k FDC3 643 645 645
+k FDC4 639 62C 645
+k FDC5 635 645 645
+k FDC6 633 62E 64A
+k FDC7 646 62C 64A
+k FDF0 635 644 6D2
+k FDF1 642 644 6D2
+k FDF2 627 644 644 647
+k FDF3 627 643 628 631
+k FDF4 645 62D 645 62F
+k FDF5 635 644 639 645
+k FDF6 631 633 648 644
+k FDF7 639 644 64A 647
+k FDF8 648 633 644 645
+k FDF9 635 644 649
+k FDFA 635 644 649 20 627 644 644 647 20 639 644 64A 647 20 648 633 644 645
+k FDFB 62C 644 20 62C 644 627 644 647
+k FDFC 631 6CC 627 644
+k FE10 2C
+k FE11 3001
+k FE12 3002
+k FE13 3A
+k FE14 3B
+k FE15 21
+k FE16 3F
+k FE17 3016
+k FE18 3017
+k FE19 2E 2E 2E
+k FE30 2E 2E
+k FE31 2014
+k FE32 2013
+k FE33 5F
+k FE34 5F
+k FE35 28
+k FE36 29
+k FE37 7B
+k FE38 7D
+k FE39 3014
+k FE3A 3015
+k FE3B 3010
+k FE3C 3011
+k FE3D 300A
+k FE3E 300B
+k FE3F 3008
+k FE40 3009
+k FE41 300C
+k FE42 300D
+k FE43 300E
+k FE44 300F
+k FE47 5B
+k FE48 5D
+k FE49 20 305
+k FE4A 20 305
+k FE4B 20 305
+k FE4C 20 305
+k FE4D 5F
+k FE4E 5F
+k FE4F 5F
+k FE50 2C
+k FE51 3001
+k FE52 2E
+k FE54 3B
+k FE55 3A
+k FE56 3F
+k FE57 21
+k FE58 2014
+k FE59 28
+k FE5A 29
+k FE5B 7B
+k FE5C 7D
+k FE5D 3014
+k FE5E 3015
+k FE5F 23
+k FE60 26
+k FE61 2A
+k FE62 2B
+k FE63 2D
+k FE64 3C
+k FE65 3E
+k FE66 3D
+k FE68 5C
+k FE69 24
+k FE6A 25
+k FE6B 40
+k FE70 20 64B
+k FE71 640 64B
+k FE72 20 64C
+k FE74 20 64D
+k FE76 20 64E
+k FE77 640 64E
+k FE78 20 64F
+k FE79 640 64F
+k FE7A 20 650
+k FE7B 640 650
+k FE7C 20 651
+k FE7D 640 651
+k FE7E 20 652
+k FE7F 640 652
+k FE80 621
+k FE81 627 653
+k FE82 627 653
+k FE83 627 654
+k FE84 627 654
+k FE85 648 654
+k FE86 648 654
+k FE87 627 655
+k FE88 627 655
+k FE89 64A 654
+k FE8A 64A 654
+k FE8B 64A 654
+k FE8C 64A 654
+k FE8D 627
+k FE8E 627
+k FE8F 628
+k FE90 628
+k FE91 628
+k FE92 628
+k FE93 629
+k FE94 629
+k FE95 62A
+k FE96 62A
+k FE97 62A
+k FE98 62A
+k FE99 62B
+k FE9A 62B
+k FE9B 62B
+k FE9C 62B
+k FE9D 62C
+k FE9E 62C
+k FE9F 62C
+k FEA0 62C
+k FEA1 62D
+k FEA2 62D
+k FEA3 62D
+k FEA4 62D
+k FEA5 62E
+k FEA6 62E
+k FEA7 62E
+k FEA8 62E
+k FEA9 62F
+k FEAA 62F
+k FEAB 630
+k FEAC 630
+k FEAD 631
+k FEAE 631
+k FEAF 632
+k FEB0 632
+k FEB1 633
+k FEB2 633
+k FEB3 633
+k FEB4 633
+k FEB5 634
+k FEB6 634
+k FEB7 634
+k FEB8 634
+k FEB9 635
+k FEBA 635
+k FEBB 635
+k FEBC 635
+k FEBD 636
+k FEBE 636
+k FEBF 636
+k FEC0 636
+k FEC1 637
+k FEC2 637
+k FEC3 637
+k FEC4 637
+k FEC5 638
+k FEC6 638
+k FEC7 638
+k FEC8 638
+k FEC9 639
+k FECA 639
+k FECB 639
+k FECC 639
+k FECD 63A
+k FECE 63A
+k FECF 63A
+k FED0 63A
+k FED1 641
+k FED2 641
+k FED3 641
+k FED4 641
+k FED5 642
+k FED6 642
+k FED7 642
+k FED8 642
+k FED9 643
+k FEDA 643
+k FEDB 643
+k FEDC 643
+k FEDD 644
+k FEDE 644
+k FEDF 644
+k FEE0 644
+k FEE1 645
+k FEE2 645
+k FEE3 645
+k FEE4 645
+k FEE5 646
+k FEE6 646
+k FEE7 646
+k FEE8 646
+k FEE9 647
+k FEEA 647
+k FEEB 647
+k FEEC 647
+k FEED 648
+k FEEE 648
+k FEEF 649
+k FEF0 649
+k FEF1 64A
+k FEF2 64A
+k FEF3 64A
+k FEF4 64A
+k FEF5 644 627 653
+k FEF6 644 627 653
+k FEF7 644 627 654
+k FEF8 644 627 654
+k FEF9 644 627 655
+k FEFA 644 627 655
+k FEFB 644 627
+k FEFC 644 627
+k FF01 21
+k FF02 22
+k FF03 23
+k FF04 24
+k FF05 25
+k FF06 26
+k FF07 27
+k FF08 28
+k FF09 29
+k FF0A 2A
+k FF0B 2B
+k FF0C 2C
+k FF0D 2D
+k FF0E 2E
+k FF0F 2F
+k FF10 30
+k FF11 31
+k FF12 32
+k FF13 33
+k FF14 34
+k FF15 35
+k FF16 36
+k FF17 37
+k FF18 38
+k FF19 39
+k FF1A 3A
+k FF1B 3B
+k FF1C 3C
+k FF1D 3D
+k FF1E 3E
+k FF1F 3F
+k FF20 40
+k FF21 41
+k FF22 42
+k FF23 43
+k FF24 44
+k FF25 45
+k FF26 46
+k FF27 47
+k FF28 48
+k FF29 49
+k FF2A 4A
+k FF2B 4B
+k FF2C 4C
+k FF2D 4D
+k FF2E 4E
+k FF2F 4F
+k FF30 50
+k FF31 51
+k FF32 52
+k FF33 53
+k FF34 54
+k FF35 55
+k FF36 56
+k FF37 57
+k FF38 58
+k FF39 59
+k FF3A 5A
+k FF3B 5B
+k FF3C 5C
+k FF3D 5D
+k FF3E 5E
+k FF3F 5F
+k FF40 60
+k FF41 61
+k FF42 62
+k FF43 63
+k FF44 64
+k FF45 65
+k FF46 66
+k FF47 67
+k FF48 68
+k FF49 69
+k FF4A 6A
+k FF4B 6B
+k FF4C 6C
+k FF4D 6D
+k FF4E 6E
+k FF4F 6F
+k FF50 70
+k FF51 71
+k FF52 72
+k FF53 73
+k FF54 74
+k FF55 75
+k FF56 76
+k FF57 77
+k FF58 78
+k FF59 79
+k FF5A 7A
+k FF5B 7B
+k FF5C 7C
+k FF5D 7D
+k FF5E 7E
+k FF5F 2985
+k FF60 2986
+k FF61 3002
+k FF62 300C
+k FF63 300D
+k FF64 3001
+k FF65 30FB
+k FF66 30F2
+k FF67 30A1
+k FF68 30A3
+k FF69 30A5
+k FF6A 30A7
+k FF6B 30A9
+k FF6C 30E3
+k FF6D 30E5
+k FF6E 30E7
+k FF6F 30C3
+k FF70 30FC
+k FF71 30A2
+k FF72 30A4
+k FF73 30A6
+k FF74 30A8
+k FF75 30AA
+k FF76 30AB
+k FF77 30AD
+k FF78 30AF
+k FF79 30B1
+k FF7A 30B3
+k FF7B 30B5
+k FF7C 30B7
+k FF7D 30B9
+k FF7E 30BB
+k FF7F 30BD
+k FF80 30BF
+k FF81 30C1
+k FF82 30C4
+k FF83 30C6
+k FF84 30C8
+k FF85 30CA
+k FF86 30CB
+k FF87 30CC
+k FF88 30CD
+k FF89 30CE
+k FF8A 30CF
+k FF8B 30D2
+k FF8C 30D5
+k FF8D 30D8
+k FF8E 30DB
+k FF8F 30DE
+k FF90 30DF
+k FF91 30E0
+k FF92 30E1
+k FF93 30E2
+k FF94 30E4
+k FF95 30E6
+k FF96 30E8
+k FF97 30E9
+k FF98 30EA
+k FF99 30EB
+k FF9A 30EC
+k FF9B 30ED
+k FF9C 30EF
+k FF9D 30F3
+k FF9E 3099
+k FF9F 309A
+k FFA0 1160
+k FFA1 1100
+k FFA2 1101
+k FFA3 11AA
+k FFA4 1102
+k FFA5 11AC
+k FFA6 11AD
+k FFA7 1103
+k FFA8 1104
+k FFA9 1105
+k FFAA 11B0
+k FFAB 11B1
+k FFAC 11B2
+k FFAD 11B3
+k FFAE 11B4
+k FFAF 11B5
+k FFB0 111A
+k FFB1 1106
+k FFB2 1107
+k FFB3 1108
+k FFB4 1121
+k FFB5 1109
+k FFB6 110A
+k FFB7 110B
+k FFB8 110C
+k FFB9 110D
+k FFBA 110E
+k FFBB 110F
+k FFBC 1110
+k FFBD 1111
+k FFBE 1112
+k FFC2 1161
+k FFC3 1162
+k FFC4 1163
+k FFC5 1164
+k FFC6 1165
+k FFC7 1166
+k FFCA 1167
+k FFCB 1168
+k FFCC 1169
+k FFCD 116A
+k FFCE 116B
+k FFCF 116C
+k FFD2 116D
+k FFD3 116E
+k FFD4 116F
+k FFD5 1170
+k FFD6 1171
+k FFD7 1172
+k FFDA 1173
+k FFDB 1174
+k FFDC 1175
+k FFE0 A2
+k FFE1 A3
+k FFE2 AC
+k FFE3 20 304
+k FFE4 A6
+k FFE5 A5
+k FFE6 20A9
+k FFE8 2502
+k FFE9 2190
+k FFEA 2191
+k FFEB 2192
+k FFEC 2193
+k FFED 25A0
+k FFEE 25CB
+k 10781 2D0
+k 10782 2D1
+k 10783 E6
+k 10784 299
+k 10785 253
+k 10787 2A3
+k 10788 AB66
+k 10789 2A5
+k 1078A 2A4
+k 1078B 256
+k 1078C 257
+k 1078D 1D91
+k 1078E 258
+k 1078F 25E
+k 10790 2A9
+k 10791 264
+k 10792 262
+k 10793 260
+k 10794 29B
+k 10795 127
+k 10796 29C
+k 10797 267
+k 10798 284
+k 10799 2AA
+k 1079A 2AB
+k 1079B 26C
+k 1079C 1DF04
+k 1079D A78E
+k 1079E 26E
+k 1079F 1DF05
+k 107A0 28E
+k 107A1 1DF06
+k 107A2 F8
+k 107A3 276
+k 107A4 277
+k 107A5 71
+k 107A6 27A
+k 107A7 1DF08
+k 107A8 27D
+k 107A9 27E
+k 107AA 280
+k 107AB 2A8
+k 107AC 2A6
+k 107AD AB67
+k 107AE 2A7
+k 107AF 288
+k 107B0 2C71
+k 107B2 28F
+k 107B3 2A1
+k 107B4 2A2
+k 107B5 298
+k 107B6 1C0
+k 107B7 1C1
+k 107B8 1C2
+k 107B9 1DF0A
+k 107BA 1DF1E
+k 1D400 41
+k 1D401 42
+k 1D402 43
+k 1D403 44
+k 1D404 45
+k 1D405 46
+k 1D406 47
+k 1D407 48
+k 1D408 49
+k 1D409 4A
+k 1D40A 4B
+k 1D40B 4C
+k 1D40C 4D
+k 1D40D 4E
+k 1D40E 4F
+k 1D40F 50
+k 1D410 51
+k 1D411 52
+k 1D412 53
+k 1D413 54
+k 1D414 55
+k 1D415 56
+k 1D416 57
+k 1D417 58
+k 1D418 59
+k 1D419 5A
+k 1D41A 61
+k 1D41B 62
+k 1D41C 63
+k 1D41D 64
+k 1D41E 65
+k 1D41F 66
+k 1D420 67
+k 1D421 68
+k 1D422 69
+k 1D423 6A
+k 1D424 6B
+k 1D425 6C
+k 1D426 6D
+k 1D427 6E
+k 1D428 6F
+k 1D429 70
+k 1D42A 71
+k 1D42B 72
+k 1D42C 73
+k 1D42D 74
+k 1D42E 75
+k 1D42F 76
+k 1D430 77
+k 1D431 78
+k 1D432 79
+k 1D433 7A
+k 1D434 41
+k 1D435 42
+k 1D436 43
+k 1D437 44
+k 1D438 45
+k 1D439 46
+k 1D43A 47
+k 1D43B 48
+k 1D43C 49
+k 1D43D 4A
+k 1D43E 4B
+k 1D43F 4C
+k 1D440 4D
+k 1D441 4E
+k 1D442 4F
+k 1D443 50
+k 1D444 51
+k 1D445 52
+k 1D446 53
+k 1D447 54
+k 1D448 55
+k 1D449 56
+k 1D44A 57
+k 1D44B 58
+k 1D44C 59
+k 1D44D 5A
+k 1D44E 61
+k 1D44F 62
+k 1D450 63
+k 1D451 64
+k 1D452 65
+k 1D453 66
+k 1D454 67
+k 1D456 69
+k 1D457 6A
+k 1D458 6B
+k 1D459 6C
+k 1D45A 6D
+k 1D45B 6E
+k 1D45C 6F
+k 1D45D 70
+k 1D45E 71
+k 1D45F 72
+k 1D460 73
+k 1D461 74
+k 1D462 75
+k 1D463 76
+k 1D464 77
+k 1D465 78
+k 1D466 79
+k 1D467 7A
+k 1D468 41
+k 1D469 42
+k 1D46A 43
+k 1D46B 44
+k 1D46C 45
+k 1D46D 46
+k 1D46E 47
+k 1D46F 48
+k 1D470 49
+k 1D471 4A
+k 1D472 4B
+k 1D473 4C
+k 1D474 4D
+k 1D475 4E
+k 1D476 4F
+k 1D477 50
+k 1D478 51
+k 1D479 52
+k 1D47A 53
+k 1D47B 54
+k 1D47C 55
+k 1D47D 56
+k 1D47E 57
+k 1D47F 58
+k 1D480 59
+k 1D481 5A
+k 1D482 61
+k 1D483 62
+k 1D484 63
+k 1D485 64
+k 1D486 65
+k 1D487 66
+k 1D488 67
+k 1D489 68
+k 1D48A 69
+k 1D48B 6A
+k 1D48C 6B
+k 1D48D 6C
+k 1D48E 6D
+k 1D48F 6E
+k 1D490 6F
+k 1D491 70
+k 1D492 71
+k 1D493 72
+k 1D494 73
+k 1D495 74
+k 1D496 75
+k 1D497 76
+k 1D498 77
+k 1D499 78
+k 1D49A 79
+k 1D49B 7A
+k 1D49C 41
+k 1D49E 43
+k 1D49F 44
+k 1D4A2 47
+k 1D4A5 4A
+k 1D4A6 4B
+k 1D4A9 4E
+k 1D4AA 4F
+k 1D4AB 50
+k 1D4AC 51
+k 1D4AE 53
+k 1D4AF 54
+k 1D4B0 55
+k 1D4B1 56
+k 1D4B2 57
+k 1D4B3 58
+k 1D4B4 59
+k 1D4B5 5A
+k 1D4B6 61
+k 1D4B7 62
+k 1D4B8 63
+k 1D4B9 64
+k 1D4BB 66
+k 1D4BD 68
+k 1D4BE 69
+k 1D4BF 6A
+k 1D4C0 6B
+k 1D4C1 6C
+k 1D4C2 6D
+k 1D4C3 6E
+k 1D4C5 70
+k 1D4C6 71
+k 1D4C7 72
+k 1D4C8 73
+k 1D4C9 74
+k 1D4CA 75
+k 1D4CB 76
+k 1D4CC 77
+k 1D4CD 78
+k 1D4CE 79
+k 1D4CF 7A
+k 1D4D0 41
+k 1D4D1 42
+k 1D4D2 43
+k 1D4D3 44
+k 1D4D4 45
+k 1D4D5 46
+k 1D4D6 47
+k 1D4D7 48
+k 1D4D8 49
+k 1D4D9 4A
+k 1D4DA 4B
+k 1D4DB 4C
+k 1D4DC 4D
+k 1D4DD 4E
+k 1D4DE 4F
+k 1D4DF 50
+k 1D4E0 51
+k 1D4E1 52
+k 1D4E2 53
+k 1D4E3 54
+k 1D4E4 55
+k 1D4E5 56
+k 1D4E6 57
+k 1D4E7 58
+k 1D4E8 59
+k 1D4E9 5A
+k 1D4EA 61
+k 1D4EB 62
+k 1D4EC 63
+k 1D4ED 64
+k 1D4EE 65
+k 1D4EF 66
+k 1D4F0 67
+k 1D4F1 68
+k 1D4F2 69
+k 1D4F3 6A
+k 1D4F4 6B
+k 1D4F5 6C
+k 1D4F6 6D
+k 1D4F7 6E
+k 1D4F8 6F
+k 1D4F9 70
+k 1D4FA 71
+k 1D4FB 72
+k 1D4FC 73
+k 1D4FD 74
+k 1D4FE 75
+k 1D4FF 76
+k 1D500 77
+k 1D501 78
+k 1D502 79
+k 1D503 7A
+k 1D504 41
+k 1D505 42
+k 1D507 44
+k 1D508 45
+k 1D509 46
+k 1D50A 47
+k 1D50D 4A
+k 1D50E 4B
+k 1D50F 4C
+k 1D510 4D
+k 1D511 4E
+k 1D512 4F
+k 1D513 50
+k 1D514 51
+k 1D516 53
+k 1D517 54
+k 1D518 55
+k 1D519 56
+k 1D51A 57
+k 1D51B 58
+k 1D51C 59
+k 1D51E 61
+k 1D51F 62
+k 1D520 63
+k 1D521 64
+k 1D522 65
+k 1D523 66
+k 1D524 67
+k 1D525 68
+k 1D526 69
+k 1D527 6A
+k 1D528 6B
+k 1D529 6C
+k 1D52A 6D
+k 1D52B 6E
+k 1D52C 6F
+k 1D52D 70
+k 1D52E 71
+k 1D52F 72
+k 1D530 73
+k 1D531 74
+k 1D532 75
+k 1D533 76
+k 1D534 77
+k 1D535 78
+k 1D536 79
+k 1D537 7A
+k 1D538 41
+k 1D539 42
+k 1D53B 44
+k 1D53C 45
+k 1D53D 46
+k 1D53E 47
+k 1D540 49
+k 1D541 4A
+k 1D542 4B
+k 1D543 4C
+k 1D544 4D
+k 1D546 4F
+k 1D54A 53
+k 1D54B 54
+k 1D54C 55
+k 1D54D 56
+k 1D54E 57
+k 1D54F 58
+k 1D550 59
+k 1D552 61
+k 1D553 62
+k 1D554 63
+k 1D555 64
+k 1D556 65
+k 1D557 66
+k 1D558 67
+k 1D559 68
+k 1D55A 69
+k 1D55B 6A
+k 1D55C 6B
+k 1D55D 6C
+k 1D55E 6D
+k 1D55F 6E
+k 1D560 6F
+k 1D561 70
+k 1D562 71
+k 1D563 72
+k 1D564 73
+k 1D565 74
+k 1D566 75
+k 1D567 76
+k 1D568 77
+k 1D569 78
+k 1D56A 79
+k 1D56B 7A
+k 1D56C 41
+k 1D56D 42
+k 1D56E 43
+k 1D56F 44
+k 1D570 45
+k 1D571 46
+k 1D572 47
+k 1D573 48
+k 1D574 49
+k 1D575 4A
+k 1D576 4B
+k 1D577 4C
+k 1D578 4D
+k 1D579 4E
+k 1D57A 4F
+k 1D57B 50
+k 1D57C 51
+k 1D57D 52
+k 1D57E 53
+k 1D57F 54
+k 1D580 55
+k 1D581 56
+k 1D582 57
+k 1D583 58
+k 1D584 59
+k 1D585 5A
+k 1D586 61
+k 1D587 62
+k 1D588 63
+k 1D589 64
+k 1D58A 65
+k 1D58B 66
+k 1D58C 67
+k 1D58D 68
+k 1D58E 69
+k 1D58F 6A
+k 1D590 6B
+k 1D591 6C
+k 1D592 6D
+k 1D593 6E
+k 1D594 6F
+k 1D595 70
+k 1D596 71
+k 1D597 72
+k 1D598 73
+k 1D599 74
+k 1D59A 75
+k 1D59B 76
+k 1D59C 77
+k 1D59D 78
+k 1D59E 79
+k 1D59F 7A
+k 1D5A0 41
+k 1D5A1 42
+k 1D5A2 43
+k 1D5A3 44
+k 1D5A4 45
+k 1D5A5 46
+k 1D5A6 47
+k 1D5A7 48
+k 1D5A8 49
+k 1D5A9 4A
+k 1D5AA 4B
+k 1D5AB 4C
+k 1D5AC 4D
+k 1D5AD 4E
+k 1D5AE 4F
+k 1D5AF 50
+k 1D5B0 51
+k 1D5B1 52
+k 1D5B2 53
+k 1D5B3 54
+k 1D5B4 55
+k 1D5B5 56
+k 1D5B6 57
+k 1D5B7 58
+k 1D5B8 59
+k 1D5B9 5A
+k 1D5BA 61
+k 1D5BB 62
+k 1D5BC 63
+k 1D5BD 64
+k 1D5BE 65
+k 1D5BF 66
+k 1D5C0 67
+k 1D5C1 68
+k 1D5C2 69
+k 1D5C3 6A
+k 1D5C4 6B
+k 1D5C5 6C
+k 1D5C6 6D
+k 1D5C7 6E
+k 1D5C8 6F
+k 1D5C9 70
+k 1D5CA 71
+k 1D5CB 72
+k 1D5CC 73
+k 1D5CD 74
+k 1D5CE 75
+k 1D5CF 76
+k 1D5D0 77
+k 1D5D1 78
+k 1D5D2 79
+k 1D5D3 7A
+k 1D5D4 41
+k 1D5D5 42
+k 1D5D6 43
+k 1D5D7 44
+k 1D5D8 45
+k 1D5D9 46
+k 1D5DA 47
+k 1D5DB 48
+k 1D5DC 49
+k 1D5DD 4A
+k 1D5DE 4B
+k 1D5DF 4C
+k 1D5E0 4D
+k 1D5E1 4E
+k 1D5E2 4F
+k 1D5E3 50
+k 1D5E4 51
+k 1D5E5 52
+k 1D5E6 53
+k 1D5E7 54
+k 1D5E8 55
+k 1D5E9 56
+k 1D5EA 57
+k 1D5EB 58
+k 1D5EC 59
+k 1D5ED 5A
+k 1D5EE 61
+k 1D5EF 62
+k 1D5F0 63
+k 1D5F1 64
+k 1D5F2 65
+k 1D5F3 66
+k 1D5F4 67
+k 1D5F5 68
+k 1D5F6 69
+k 1D5F7 6A
+k 1D5F8 6B
+k 1D5F9 6C
+k 1D5FA 6D
+k 1D5FB 6E
+k 1D5FC 6F
+k 1D5FD 70
+k 1D5FE 71
+k 1D5FF 72
+k 1D600 73
+k 1D601 74
+k 1D602 75
+k 1D603 76
+k 1D604 77
+k 1D605 78
+k 1D606 79
+k 1D607 7A
+k 1D608 41
+k 1D609 42
+k 1D60A 43
+k 1D60B 44
+k 1D60C 45
+k 1D60D 46
+k 1D60E 47
+k 1D60F 48
+k 1D610 49
+k 1D611 4A
+k 1D612 4B
+k 1D613 4C
+k 1D614 4D
+k 1D615 4E
+k 1D616 4F
+k 1D617 50
+k 1D618 51
+k 1D619 52
+k 1D61A 53
+k 1D61B 54
+k 1D61C 55
+k 1D61D 56
+k 1D61E 57
+k 1D61F 58
+k 1D620 59
+k 1D621 5A
+k 1D622 61
+k 1D623 62
+k 1D624 63
+k 1D625 64
+k 1D626 65
+k 1D627 66
+k 1D628 67
+k 1D629 68
+k 1D62A 69
+k 1D62B 6A
+k 1D62C 6B
+k 1D62D 6C
+k 1D62E 6D
+k 1D62F 6E
+k 1D630 6F
+k 1D631 70
+k 1D632 71
+k 1D633 72
+k 1D634 73
+k 1D635 74
+k 1D636 75
+k 1D637 76
+k 1D638 77
+k 1D639 78
+k 1D63A 79
+k 1D63B 7A
+k 1D63C 41
+k 1D63D 42
+k 1D63E 43
+k 1D63F 44
+k 1D640 45
+k 1D641 46
+k 1D642 47
+k 1D643 48
+k 1D644 49
+k 1D645 4A
+k 1D646 4B
+k 1D647 4C
+k 1D648 4D
+k 1D649 4E
+k 1D64A 4F
+k 1D64B 50
+k 1D64C 51
+k 1D64D 52
+k 1D64E 53
+k 1D64F 54
+k 1D650 55
+k 1D651 56
+k 1D652 57
+k 1D653 58
+k 1D654 59
+k 1D655 5A
+k 1D656 61
+k 1D657 62
+k 1D658 63
+k 1D659 64
+k 1D65A 65
+k 1D65B 66
+k 1D65C 67
+k 1D65D 68
+k 1D65E 69
+k 1D65F 6A
+k 1D660 6B
+k 1D661 6C
+k 1D662 6D
+k 1D663 6E
+k 1D664 6F
+k 1D665 70
+k 1D666 71
+k 1D667 72
+k 1D668 73
+k 1D669 74
+k 1D66A 75
+k 1D66B 76
+k 1D66C 77
+k 1D66D 78
+k 1D66E 79
+k 1D66F 7A
+k 1D670 41
+k 1D671 42
+k 1D672 43
+k 1D673 44
+k 1D674 45
+k 1D675 46
+k 1D676 47
+k 1D677 48
+k 1D678 49
+k 1D679 4A
+k 1D67A 4B
+k 1D67B 4C
+k 1D67C 4D
+k 1D67D 4E
+k 1D67E 4F
+k 1D67F 50
+k 1D680 51
+k 1D681 52
+k 1D682 53
+k 1D683 54
+k 1D684 55
+k 1D685 56
+k 1D686 57
+k 1D687 58
+k 1D688 59
+k 1D689 5A
+k 1D68A 61
+k 1D68B 62
+k 1D68C 63
+k 1D68D 64
+k 1D68E 65
+k 1D68F 66
+k 1D690 67
+k 1D691 68
+k 1D692 69
+k 1D693 6A
+k 1D694 6B
+k 1D695 6C
+k 1D696 6D
+k 1D697 6E
+k 1D698 6F
+k 1D699 70
+k 1D69A 71
+k 1D69B 72
+k 1D69C 73
+k 1D69D 74
+k 1D69E 75
+k 1D69F 76
+k 1D6A0 77
+k 1D6A1 78
+k 1D6A2 79
+k 1D6A3 7A
+k 1D6A4 131
+k 1D6A5 237
+k 1D6A8 391
+k 1D6A9 392
+k 1D6AA 393
+k 1D6AB 394
+k 1D6AC 395
+k 1D6AD 396
+k 1D6AE 397
+k 1D6AF 398
+k 1D6B0 399
+k 1D6B1 39A
+k 1D6B2 39B
+k 1D6B3 39C
+k 1D6B4 39D
+k 1D6B5 39E
+k 1D6B6 39F
+k 1D6B7 3A0
+k 1D6B8 3A1
+k 1D6B9 398
+k 1D6BA 3A3
+k 1D6BB 3A4
+k 1D6BC 3A5
+k 1D6BD 3A6
+k 1D6BE 3A7
+k 1D6BF 3A8
+k 1D6C0 3A9
+k 1D6C1 2207
+k 1D6C2 3B1
+k 1D6C3 3B2
+k 1D6C4 3B3
+k 1D6C5 3B4
+k 1D6C6 3B5
+k 1D6C7 3B6
+k 1D6C8 3B7
+k 1D6C9 3B8
+k 1D6CA 3B9
+k 1D6CB 3BA
+k 1D6CC 3BB
+k 1D6CD 3BC
+k 1D6CE 3BD
+k 1D6CF 3BE
+k 1D6D0 3BF
+k 1D6D1 3C0
+k 1D6D2 3C1
+k 1D6D3 3C2
+k 1D6D4 3C3
+k 1D6D5 3C4
+k 1D6D6 3C5
+k 1D6D7 3C6
+k 1D6D8 3C7
+k 1D6D9 3C8
+k 1D6DA 3C9
+k 1D6DB 2202
+k 1D6DC 3B5
+k 1D6DD 3B8
+k 1D6DE 3BA
+k 1D6DF 3C6
+k 1D6E0 3C1
+k 1D6E1 3C0
+k 1D6E2 391
+k 1D6E3 392
+k 1D6E4 393
+k 1D6E5 394
+k 1D6E6 395
+k 1D6E7 396
+k 1D6E8 397
+k 1D6E9 398
+k 1D6EA 399
+k 1D6EB 39A
+k 1D6EC 39B
+k 1D6ED 39C
+k 1D6EE 39D
+k 1D6EF 39E
+k 1D6F0 39F
+k 1D6F1 3A0
+k 1D6F2 3A1
+k 1D6F3 398
+k 1D6F4 3A3
+k 1D6F5 3A4
+k 1D6F6 3A5
+k 1D6F7 3A6
+k 1D6F8 3A7
+k 1D6F9 3A8
+k 1D6FA 3A9
+k 1D6FB 2207
+k 1D6FC 3B1
+k 1D6FD 3B2
+k 1D6FE 3B3
+k 1D6FF 3B4
+k 1D700 3B5
+k 1D701 3B6
+k 1D702 3B7
+k 1D703 3B8
+k 1D704 3B9
+k 1D705 3BA
+k 1D706 3BB
+k 1D707 3BC
+k 1D708 3BD
+k 1D709 3BE
+k 1D70A 3BF
+k 1D70B 3C0
+k 1D70C 3C1
+k 1D70D 3C2
+k 1D70E 3C3
+k 1D70F 3C4
+k 1D710 3C5
+k 1D711 3C6
+k 1D712 3C7
+k 1D713 3C8
+k 1D714 3C9
+k 1D715 2202
+k 1D716 3B5
+k 1D717 3B8
+k 1D718 3BA
+k 1D719 3C6
+k 1D71A 3C1
+k 1D71B 3C0
+k 1D71C 391
+k 1D71D 392
+k 1D71E 393
+k 1D71F 394
+k 1D720 395
+k 1D721 396
+k 1D722 397
+k 1D723 398
+k 1D724 399
+k 1D725 39A
+k 1D726 39B
+k 1D727 39C
+k 1D728 39D
+k 1D729 39E
+k 1D72A 39F
+k 1D72B 3A0
+k 1D72C 3A1
+k 1D72D 398
+k 1D72E 3A3
+k 1D72F 3A4
+k 1D730 3A5
+k 1D731 3A6
+k 1D732 3A7
+k 1D733 3A8
+k 1D734 3A9
+k 1D735 2207
+k 1D736 3B1
+k 1D737 3B2
+k 1D738 3B3
+k 1D739 3B4
+k 1D73A 3B5
+k 1D73B 3B6
+k 1D73C 3B7
+k 1D73D 3B8
+k 1D73E 3B9
+k 1D73F 3BA
+k 1D740 3BB
+k 1D741 3BC
+k 1D742 3BD
+k 1D743 3BE
+k 1D744 3BF
+k 1D745 3C0
+k 1D746 3C1
+k 1D747 3C2
+k 1D748 3C3
+k 1D749 3C4
+k 1D74A 3C5
+k 1D74B 3C6
+k 1D74C 3C7
+k 1D74D 3C8
+k 1D74E 3C9
+k 1D74F 2202
+k 1D750 3B5
+k 1D751 3B8
+k 1D752 3BA
+k 1D753 3C6
+k 1D754 3C1
+k 1D755 3C0
+k 1D756 391
+k 1D757 392
+k 1D758 393
+k 1D759 394
+k 1D75A 395
+k 1D75B 396
+k 1D75C 397
+k 1D75D 398
+k 1D75E 399
+k 1D75F 39A
+k 1D760 39B
+k 1D761 39C
+k 1D762 39D
+k 1D763 39E
+k 1D764 39F
+k 1D765 3A0
+k 1D766 3A1
+k 1D767 398
+k 1D768 3A3
+k 1D769 3A4
+k 1D76A 3A5
+k 1D76B 3A6
+k 1D76C 3A7
+k 1D76D 3A8
+k 1D76E 3A9
+k 1D76F 2207
+k 1D770 3B1
+k 1D771 3B2
+k 1D772 3B3
+k 1D773 3B4
+k 1D774 3B5
+k 1D775 3B6
+k 1D776 3B7
+k 1D777 3B8
+k 1D778 3B9
+k 1D779 3BA
+k 1D77A 3BB
+k 1D77B 3BC
+k 1D77C 3BD
+k 1D77D 3BE
+k 1D77E 3BF
+k 1D77F 3C0
+k 1D780 3C1
+k 1D781 3C2
+k 1D782 3C3
+k 1D783 3C4
+k 1D784 3C5
+k 1D785 3C6
+k 1D786 3C7
+k 1D787 3C8
+k 1D788 3C9
+k 1D789 2202
+k 1D78A 3B5
+k 1D78B 3B8
+k 1D78C 3BA
+k 1D78D 3C6
+k 1D78E 3C1
+k 1D78F 3C0
+k 1D790 391
+k 1D791 392
+k 1D792 393
+k 1D793 394
+k 1D794 395
+k 1D795 396
+k 1D796 397
+k 1D797 398
+k 1D798 399
+k 1D799 39A
+k 1D79A 39B
+k 1D79B 39C
+k 1D79C 39D
+k 1D79D 39E
+k 1D79E 39F
+k 1D79F 3A0
+k 1D7A0 3A1
+k 1D7A1 398
+k 1D7A2 3A3
+k 1D7A3 3A4
+k 1D7A4 3A5
+k 1D7A5 3A6
+k 1D7A6 3A7
+k 1D7A7 3A8
+k 1D7A8 3A9
+k 1D7A9 2207
+k 1D7AA 3B1
+k 1D7AB 3B2
+k 1D7AC 3B3
+k 1D7AD 3B4
+k 1D7AE 3B5
+k 1D7AF 3B6
+k 1D7B0 3B7
+k 1D7B1 3B8
+k 1D7B2 3B9
+k 1D7B3 3BA
+k 1D7B4 3BB
+k 1D7B5 3BC
+k 1D7B6 3BD
+k 1D7B7 3BE
+k 1D7B8 3BF
+k 1D7B9 3C0
+k 1D7BA 3C1
+k 1D7BB 3C2
+k 1D7BC 3C3
+k 1D7BD 3C4
+k 1D7BE 3C5
+k 1D7BF 3C6
+k 1D7C0 3C7
+k 1D7C1 3C8
+k 1D7C2 3C9
+k 1D7C3 2202
+k 1D7C4 3B5
+k 1D7C5 3B8
+k 1D7C6 3BA
+k 1D7C7 3C6
+k 1D7C8 3C1
+k 1D7C9 3C0
+k 1D7CA 3DC
+k 1D7CB 3DD
+k 1D7CE 30
+k 1D7CF 31
+k 1D7D0 32
+k 1D7D1 33
+k 1D7D2 34
+k 1D7D3 35
+k 1D7D4 36
+k 1D7D5 37
+k 1D7D6 38
+k 1D7D7 39
+k 1D7D8 30
+k 1D7D9 31
+k 1D7DA 32
+k 1D7DB 33
+k 1D7DC 34
+k 1D7DD 35
+k 1D7DE 36
+k 1D7DF 37
+k 1D7E0 38
+k 1D7E1 39
+k 1D7E2 30
+k 1D7E3 31
+k 1D7E4 32
+k 1D7E5 33
+k 1D7E6 34
+k 1D7E7 35
+k 1D7E8 36
+k 1D7E9 37
+k 1D7EA 38
+k 1D7EB 39
+k 1D7EC 30
+k 1D7ED 31
+k 1D7EE 32
+k 1D7EF 33
+k 1D7F0 34
+k 1D7F1 35
+k 1D7F2 36
+k 1D7F3 37
+k 1D7F4 38
+k 1D7F5 39
+k 1D7F6 30
+k 1D7F7 31
+k 1D7F8 32
+k 1D7F9 33
+k 1D7FA 34
+k 1D7FB 35
+k 1D7FC 36
+k 1D7FD 37
+k 1D7FE 38
+k 1D7FF 39
+k 1E030 430
+k 1E031 431
+k 1E032 432
+k 1E033 433
+k 1E034 434
+k 1E035 435
+k 1E036 436
+k 1E037 437
+k 1E038 438
+k 1E039 43A
+k 1E03A 43B
+k 1E03B 43C
+k 1E03C 43E
+k 1E03D 43F
+k 1E03E 440
+k 1E03F 441
+k 1E040 442
+k 1E041 443
+k 1E042 444
+k 1E043 445
+k 1E044 446
+k 1E045 447
+k 1E046 448
+k 1E047 44B
+k 1E048 44D
+k 1E049 44E
+k 1E04A A689
+k 1E04B 4D9
+k 1E04C 456
+k 1E04D 458
+k 1E04E 4E9
+k 1E04F 4AF
+k 1E050 4CF
+k 1E051 430
+k 1E052 431
+k 1E053 432
+k 1E054 433
+k 1E055 434
+k 1E056 435
+k 1E057 436
+k 1E058 437
+k 1E059 438
+k 1E05A 43A
+k 1E05B 43B
+k 1E05C 43E
+k 1E05D 43F
+k 1E05E 441
+k 1E05F 443
+k 1E060 444
+k 1E061 445
+k 1E062 446
+k 1E063 447
+k 1E064 448
+k 1E065 44A
+k 1E066 44B
+k 1E067 491
+k 1E068 456
+k 1E069 455
+k 1E06A 45F
+k 1E06B 4AB
+k 1E06C A651
+k 1E06D 4B1
+k 1EE00 627
+k 1EE01 628
+k 1EE02 62C
+k 1EE03 62F
+k 1EE05 648
+k 1EE06 632
+k 1EE07 62D
+k 1EE08 637
+k 1EE09 64A
+k 1EE0A 643
+k 1EE0B 644
+k 1EE0C 645
+k 1EE0D 646
+k 1EE0E 633
+k 1EE0F 639
+k 1EE10 641
+k 1EE11 635
+k 1EE12 642
+k 1EE13 631
+k 1EE14 634
+k 1EE15 62A
+k 1EE16 62B
+k 1EE17 62E
+k 1EE18 630
+k 1EE19 636
+k 1EE1A 638
+k 1EE1B 63A
+k 1EE1C 66E
+k 1EE1D 6BA
+k 1EE1E 6A1
+k 1EE1F 66F
+k 1EE21 628
+k 1EE22 62C
+k 1EE24 647
+k 1EE27 62D
+k 1EE29 64A
+k 1EE2A 643
+k 1EE2B 644
+k 1EE2C 645
+k 1EE2D 646
+k 1EE2E 633
+k 1EE2F 639
+k 1EE30 641
+k 1EE31 635
+k 1EE32 642
+k 1EE34 634
+k 1EE35 62A
+k 1EE36 62B
+k 1EE37 62E
+k 1EE39 636
+k 1EE3B 63A
+k 1EE42 62C
+k 1EE47 62D
+k 1EE49 64A
+k 1EE4B 644
+k 1EE4D 646
+k 1EE4E 633
+k 1EE4F 639
+k 1EE51 635
+k 1EE52 642
+k 1EE54 634
+k 1EE57 62E
+k 1EE59 636
+k 1EE5B 63A
+k 1EE5D 6BA
+k 1EE5F 66F
+k 1EE61 628
+k 1EE62 62C
+k 1EE64 647
+k 1EE67 62D
+k 1EE68 637
+k 1EE69 64A
+k 1EE6A 643
+k 1EE6C 645
+k 1EE6D 646
+k 1EE6E 633
+k 1EE6F 639
+k 1EE70 641
+k 1EE71 635
+k 1EE72 642
+k 1EE74 634
+k 1EE75 62A
+k 1EE76 62B
+k 1EE77 62E
+k 1EE79 636
+k 1EE7A 638
+k 1EE7B 63A
+k 1EE7C 66E
+k 1EE7E 6A1
+k 1EE80 627
+k 1EE81 628
+k 1EE82 62C
+k 1EE83 62F
+k 1EE84 647
+k 1EE85 648
+k 1EE86 632
+k 1EE87 62D
+k 1EE88 637
+k 1EE89 64A
+k 1EE8B 644
+k 1EE8C 645
+k 1EE8D 646
+k 1EE8E 633
+k 1EE8F 639
+k 1EE90 641
+k 1EE91 635
+k 1EE92 642
+k 1EE93 631
+k 1EE94 634
+k 1EE95 62A
+k 1EE96 62B
+k 1EE97 62E
+k 1EE98 630
+k 1EE99 636
+k 1EE9A 638
+k 1EE9B 63A
+k 1EEA1 628
+k 1EEA2 62C
+k 1EEA3 62F
+k 1EEA5 648
+k 1EEA6 632
+k 1EEA7 62D
+k 1EEA8 637
+k 1EEA9 64A
+k 1EEAB 644
+k 1EEAC 645
+k 1EEAD 646
+k 1EEAE 633
+k 1EEAF 639
+k 1EEB0 641
+k 1EEB1 635
+k 1EEB2 642
+k 1EEB3 631
+k 1EEB4 634
+k 1EEB5 62A
+k 1EEB6 62B
+k 1EEB7 62E
+k 1EEB8 630
+k 1EEB9 636
+k 1EEBA 638
+k 1EEBB 63A
+k 1F100 30 2E
+k 1F101 30 2C
+k 1F102 31 2C
+k 1F103 32 2C
+k 1F104 33 2C
+k 1F105 34 2C
+k 1F106 35 2C
+k 1F107 36 2C
+k 1F108 37 2C
+k 1F109 38 2C
+k 1F10A 39 2C
+k 1F110 28 41 29
+k 1F111 28 42 29
+k 1F112 28 43 29
+k 1F113 28 44 29
+k 1F114 28 45 29
+k 1F115 28 46 29
+k 1F116 28 47 29
+k 1F117 28 48 29
+k 1F118 28 49 29
+k 1F119 28 4A 29
+k 1F11A 28 4B 29
+k 1F11B 28 4C 29
+k 1F11C 28 4D 29
+k 1F11D 28 4E 29
+k 1F11E 28 4F 29
+k 1F11F 28 50 29
+k 1F120 28 51 29
+k 1F121 28 52 29
+k 1F122 28 53 29
+k 1F123 28 54 29
+k 1F124 28 55 29
+k 1F125 28 56 29
+k 1F126 28 57 29
+k 1F127 28 58 29
+k 1F128 28 59 29
+k 1F129 28 5A 29
+k 1F12A 3014 53 3015
+k 1F12B 43
+k 1F12C 52
+k 1F12D 43 44
+k 1F12E 57 5A
+k 1F130 41
+k 1F131 42
+k 1F132 43
+k 1F133 44
+k 1F134 45
+k 1F135 46
+k 1F136 47
+k 1F137 48
+k 1F138 49
+k 1F139 4A
+k 1F13A 4B
+k 1F13B 4C
+k 1F13C 4D
+k 1F13D 4E
+k 1F13E 4F
+k 1F13F 50
+k 1F140 51
+k 1F141 52
+k 1F142 53
+k 1F143 54
+k 1F144 55
+k 1F145 56
+k 1F146 57
+k 1F147 58
+k 1F148 59
+k 1F149 5A
+k 1F14A 48 56
+k 1F14B 4D 56
+k 1F14C 53 44
+k 1F14D 53 53
+k 1F14E 50 50 56
+k 1F14F 57 43
+k 1F16A 4D 43
+k 1F16B 4D 44
+k 1F16C 4D 52
+k 1F190 44 4A
+k 1F200 307B 304B
+k 1F201 30B3 30B3
+k 1F202 30B5
+k 1F210 624B
+k 1F211 5B57
+k 1F212 53CC
+k 1F213 30C6 3099
+k 1F214 4E8C
+k 1F215 591A
+k 1F216 89E3
+k 1F217 5929
+k 1F218 4EA4
+k 1F219 6620
+k 1F21A 7121
+k 1F21B 6599
+k 1F21C 524D
+k 1F21D 5F8C
+k 1F21E 518D
+k 1F21F 65B0
+k 1F220 521D
+k 1F221 7D42
+k 1F222 751F
+k 1F223 8CA9
+k 1F224 58F0
+k 1F225 5439
+k 1F226 6F14
+k 1F227 6295
+k 1F228 6355
+k 1F229 4E00
+k 1F22A 4E09
+k 1F22B 904A
+k 1F22C 5DE6
+k 1F22D 4E2D
+k 1F22E 53F3
+k 1F22F 6307
+k 1F230 8D70
+k 1F231 6253
+k 1F232 7981
+k 1F233 7A7A
+k 1F234 5408
+k 1F235 6E80
+k 1F236 6709
+k 1F237 6708
+k 1F238 7533
+k 1F239 5272
+k 1F23A 55B6
+k 1F23B 914D
+k 1F240 3014 672C 3015
+k 1F241 3014 4E09 3015
+k 1F242 3014 4E8C 3015
+k 1F243 3014 5B89 3015
+k 1F244 3014 70B9 3015
+k 1F245 3014 6253 3015
+k 1F246 3014 76D7 3015
+k 1F247 3014 52DD 3015
+k 1F248 3014 6557 3015
+k 1F250 5F97
+k 1F251 53EF
+k 1FBF0 30
+k 1FBF1 31
+k 1FBF2 32
+k 1FBF3 33
+k 1FBF4 34
+k 1FBF5 35
+k 1FBF6 36
+k 1FBF7 37
+k 1FBF8 38
+k 1FBF9 39
+p 41 300 C0
+p 41 301 C1
+p 41 302 C2
+p 41 303 C3
+p 41 308 C4
+p 41 30A C5
+p 43 327 C7
+p 45 300 C8
+p 45 301 C9
+p 45 302 CA
+p 45 308 CB
+p 49 300 CC
+p 49 301 CD
+p 49 302 CE
+p 49 308 CF
+p 4E 303 D1
+p 4F 300 D2
+p 4F 301 D3
+p 4F 302 D4
+p 4F 303 D5
+p 4F 308 D6
+p 55 300 D9
+p 55 301 DA
+p 55 302 DB
+p 55 308 DC
+p 59 301 DD
+p 61 300 E0
+p 61 301 E1
+p 61 302 E2
+p 61 303 E3
+p 61 308 E4
+p 61 30A E5
+p 63 327 E7
+p 65 300 E8
+p 65 301 E9
+p 65 302 EA
+p 65 308 EB
+p 69 300 EC
+p 69 301 ED
+p 69 302 EE
+p 69 308 EF
+p 6E 303 F1
+p 6F 300 F2
+p 6F 301 F3
+p 6F 302 F4
+p 6F 303 F5
+p 6F 308 F6
+p 75 300 F9
+p 75 301 FA
+p 75 302 FB
+p 75 308 FC
+p 79 301 FD
+p 79 308 FF
+p 41 304 100
+p 61 304 101
+p 41 306 102
+p 61 306 103
+p 41 328 104
+p 61 328 105
+p 43 301 106
+p 63 301 107
+p 43 302 108
+p 63 302 109
+p 43 307 10A
+p 63 307 10B
+p 43 30C 10C
+p 63 30C 10D
+p 44 30C 10E
+p 64 30C 10F
+p 45 304 112
+p 65 304 113
+p 45 306 114
+p 65 306 115
+p 45 307 116
+p 65 307 117
+p 45 328 118
+p 65 328 119
+p 45 30C 11A
+p 65 30C 11B
+p 47 302 11C
+p 67 302 11D
+p 47 306 11E
+p 67 306 11F
+p 47 307 120
+p 67 307 121
+p 47 327 122
+p 67 327 123
+p 48 302 124
+p 68 302 125
+p 49 303 128
+p 69 303 129
+p 49 304 12A
+p 69 304 12B
+p 49 306 12C
+p 69 306 12D
+p 49 328 12E
+p 69 328 12F
+p 49 307 130
+p 4A 302 134
+p 6A 302 135
+p 4B 327 136
+p 6B 327 137
+p 4C 301 139
+p 6C 301 13A
+p 4C 327 13B
+p 6C 327 13C
+p 4C 30C 13D
+p 6C 30C 13E
+p 4E 301 143
+p 6E 301 144
+p 4E 327 145
+p 6E 327 146
+p 4E 30C 147
+p 6E 30C 148
+p 4F 304 14C
+p 6F 304 14D
+p 4F 306 14E
+p 6F 306 14F
+p 4F 30B 150
+p 6F 30B 151
+p 52 301 154
+p 72 301 155
+p 52 327 156
+p 72 327 157
+p 52 30C 158
+p 72 30C 159
+p 53 301 15A
+p 73 301 15B
+p 53 302 15C
+p 73 302 15D
+p 53 327 15E
+p 73 327 15F
+p 53 30C 160
+p 73 30C 161
+p 54 327 162
+p 74 327 163
+p 54 30C 164
+p 74 30C 165
+p 55 303 168
+p 75 303 169
+p 55 304 16A
+p 75 304 16B
+p 55 306 16C
+p 75 306 16D
+p 55 30A 16E
+p 75 30A 16F
+p 55 30B 170
+p 75 30B 171
+p 55 328 172
+p 75 328 173
+p 57 302 174
+p 77 302 175
+p 59 302 176
+p 79 302 177
+p 59 308 178
+p 5A 301 179
+p 7A 301 17A
+p 5A 307 17B
+p 7A 307 17C
+p 5A 30C 17D
+p 7A 30C 17E
+p 4F 31B 1A0
+p 6F 31B 1A1
+p 55 31B 1AF
+p 75 31B 1B0
+p 41 30C 1CD
+p 61 30C 1CE
+p 49 30C 1CF
+p 69 30C 1D0
+p 4F 30C 1D1
+p 6F 30C 1D2
+p 55 30C 1D3
+p 75 30C 1D4
+p DC 304 1D5
+p FC 304 1D6
+p DC 301 1D7
+p FC 301 1D8
+p DC 30C 1D9
+p FC 30C 1DA
+p DC 300 1DB
+p FC 300 1DC
+p C4 304 1DE
+p E4 304 1DF
+p 226 304 1E0
+p 227 304 1E1
+p C6 304 1E2
+p E6 304 1E3
+p 47 30C 1E6
+p 67 30C 1E7
+p 4B 30C 1E8
+p 6B 30C 1E9
+p 4F 328 1EA
+p 6F 328 1EB
+p 1EA 304 1EC
+p 1EB 304 1ED
+p 1B7 30C 1EE
+p 292 30C 1EF
+p 6A 30C 1F0
+p 47 301 1F4
+p 67 301 1F5
+p 4E 300 1F8
+p 6E 300 1F9
+p C5 301 1FA
+p E5 301 1FB
+p C6 301 1FC
+p E6 301 1FD
+p D8 301 1FE
+p F8 301 1FF
+p 41 30F 200
+p 61 30F 201
+p 41 311 202
+p 61 311 203
+p 45 30F 204
+p 65 30F 205
+p 45 311 206
+p 65 311 207
+p 49 30F 208
+p 69 30F 209
+p 49 311 20A
+p 69 311 20B
+p 4F 30F 20C
+p 6F 30F 20D
+p 4F 311 20E
+p 6F 311 20F
+p 52 30F 210
+p 72 30F 211
+p 52 311 212
+p 72 311 213
+p 55 30F 214
+p 75 30F 215
+p 55 311 216
+p 75 311 217
+p 53 326 218
+p 73 326 219
+p 54 326 21A
+p 74 326 21B
+p 48 30C 21E
+p 68 30C 21F
+p 41 307 226
+p 61 307 227
+p 45 327 228
+p 65 327 229
+p D6 304 22A
+p F6 304 22B
+p D5 304 22C
+p F5 304 22D
+p 4F 307 22E
+p 6F 307 22F
+p 22E 304 230
+p 22F 304 231
+p 59 304 232
+p 79 304 233
+p A8 301 385
+p 391 301 386
+p 395 301 388
+p 397 301 389
+p 399 301 38A
+p 39F 301 38C
+p 3A5 301 38E
+p 3A9 301 38F
+p 3CA 301 390
+p 399 308 3AA
+p 3A5 308 3AB
+p 3B1 301 3AC
+p 3B5 301 3AD
+p 3B7 301 3AE
+p 3B9 301 3AF
+p 3CB 301 3B0
+p 3B9 308 3CA
+p 3C5 308 3CB
+p 3BF 301 3CC
+p 3C5 301 3CD
+p 3C9 301 3CE
+p 3D2 301 3D3
+p 3D2 308 3D4
+p 415 300 400
+p 415 308 401
+p 413 301 403
+p 406 308 407
+p 41A 301 40C
+p 418 300 40D
+p 423 306 40E
+p 418 306 419
+p 438 306 439
+p 435 300 450
+p 435 308 451
+p 433 301 453
+p 456 308 457
+p 43A 301 45C
+p 438 300 45D
+p 443 306 45E
+p 474 30F 476
+p 475 30F 477
+p 416 306 4C1
+p 436 306 4C2
+p 410 306 4D0
+p 430 306 4D1
+p 410 308 4D2
+p 430 308 4D3
+p 415 306 4D6
+p 435 306 4D7
+p 4D8 308 4DA
+p 4D9 308 4DB
+p 416 308 4DC
+p 436 308 4DD
+p 417 308 4DE
+p 437 308 4DF
+p 418 304 4E2
+p 438 304 4E3
+p 418 308 4E4
+p 438 308 4E5
+p 41E 308 4E6
+p 43E 308 4E7
+p 4E8 308 4EA
+p 4E9 308 4EB
+p 42D 308 4EC
+p 44D 308 4ED
+p 423 304 4EE
+p 443 304 4EF
+p 423 308 4F0
+p 443 308 4F1
+p 423 30B 4F2
+p 443 30B 4F3
+p 427 308 4F4
+p 447 308 4F5
+p 42B 308 4F8
+p 44B 308 4F9
+p 627 653 622
+p 627 654 623
+p 648 654 624
+p 627 655 625
+p 64A 654 626
+p 6D5 654 6C0
+p 6C1 654 6C2
+p 6D2 654 6D3
+p 928 93C 929
+p 930 93C 931
+p 933 93C 934
+p 9C7 9BE 9CB
+p 9C7 9D7 9CC
+p B47 B56 B48
+p B47 B3E B4B
+p B47 B57 B4C
+p B92 BD7 B94
+p BC6 BBE BCA
+p BC7 BBE BCB
+p BC6 BD7 BCC
+p C46 C56 C48
+p CBF CD5 CC0
+p CC6 CD5 CC7
+p CC6 CD6 CC8
+p CC6 CC2 CCA
+p CCA CD5 CCB
+p D46 D3E D4A
+p D47 D3E D4B
+p D46 D57 D4C
+p DD9 DCA DDA
+p DD9 DCF DDC
+p DDC DCA DDD
+p DD9 DDF DDE
+p 1025 102E 1026
+p 1B05 1B35 1B06
+p 1B07 1B35 1B08
+p 1B09 1B35 1B0A
+p 1B0B 1B35 1B0C
+p 1B0D 1B35 1B0E
+p 1B11 1B35 1B12
+p 1B3A 1B35 1B3B
+p 1B3C 1B35 1B3D
+p 1B3E 1B35 1B40
+p 1B3F 1B35 1B41
+p 1B42 1B35 1B43
+p 41 325 1E00
+p 61 325 1E01
+p 42 307 1E02
+p 62 307 1E03
+p 42 323 1E04
+p 62 323 1E05
+p 42 331 1E06
+p 62 331 1E07
+p C7 301 1E08
+p E7 301 1E09
+p 44 307 1E0A
+p 64 307 1E0B
+p 44 323 1E0C
+p 64 323 1E0D
+p 44 331 1E0E
+p 64 331 1E0F
+p 44 327 1E10
+p 64 327 1E11
+p 44 32D 1E12
+p 64 32D 1E13
+p 112 300 1E14
+p 113 300 1E15
+p 112 301 1E16
+p 113 301 1E17
+p 45 32D 1E18
+p 65 32D 1E19
+p 45 330 1E1A
+p 65 330 1E1B
+p 228 306 1E1C
+p 229 306 1E1D
+p 46 307 1E1E
+p 66 307 1E1F
+p 47 304 1E20
+p 67 304 1E21
+p 48 307 1E22
+p 68 307 1E23
+p 48 323 1E24
+p 68 323 1E25
+p 48 308 1E26
+p 68 308 1E27
+p 48 327 1E28
+p 68 327 1E29
+p 48 32E 1E2A
+p 68 32E 1E2B
+p 49 330 1E2C
+p 69 330 1E2D
+p CF 301 1E2E
+p EF 301 1E2F
+p 4B 301 1E30
+p 6B 301 1E31
+p 4B 323 1E32
+p 6B 323 1E33
+p 4B 331 1E34
+p 6B 331 1E35
+p 4C 323 1E36
+p 6C 323 1E37
+p 1E36 304 1E38
+p 1E37 304 1E39
+p 4C 331 1E3A
+p 6C 331 1E3B
+p 4C 32D 1E3C
+p 6C 32D 1E3D
+p 4D 301 1E3E
+p 6D 301 1E3F
+p 4D 307 1E40
+p 6D 307 1E41
+p 4D 323 1E42
+p 6D 323 1E43
+p 4E 307 1E44
+p 6E 307 1E45
+p 4E 323 1E46
+p 6E 323 1E47
+p 4E 331 1E48
+p 6E 331 1E49
+p 4E 32D 1E4A
+p 6E 32D 1E4B
+p D5 301 1E4C
+p F5 301 1E4D
+p D5 308 1E4E
+p F5 308 1E4F
+p 14C 300 1E50
+p 14D 300 1E51
+p 14C 301 1E52
+p 14D 301 1E53
+p 50 301 1E54
+p 70 301 1E55
+p 50 307 1E56
+p 70 307 1E57
+p 52 307 1E58
+p 72 307 1E59
+p 52 323 1E5A
+p 72 323 1E5B
+p 1E5A 304 1E5C
+p 1E5B 304 1E5D
+p 52 331 1E5E
+p 72 331 1E5F
+p 53 307 1E60
+p 73 307 1E61
+p 53 323 1E62
+p 73 323 1E63
+p 15A 307 1E64
+p 15B 307 1E65
+p 160 307 1E66
+p 161 307 1E67
+p 1E62 307 1E68
+p 1E63 307 1E69
+p 54 307 1E6A
+p 74 307 1E6B
+p 54 323 1E6C
+p 74 323 1E6D
+p 54 331 1E6E
+p 74 331 1E6F
+p 54 32D 1E70
+p 74 32D 1E71
+p 55 324 1E72
+p 75 324 1E73
+p 55 330 1E74
+p 75 330 1E75
+p 55 32D 1E76
+p 75 32D 1E77
+p 168 301 1E78
+p 169 301 1E79
+p 16A 308 1E7A
+p 16B 308 1E7B
+p 56 303 1E7C
+p 76 303 1E7D
+p 56 323 1E7E
+p 76 323 1E7F
+p 57 300 1E80
+p 77 300 1E81
+p 57 301 1E82
+p 77 301 1E83
+p 57 308 1E84
+p 77 308 1E85
+p 57 307 1E86
+p 77 307 1E87
+p 57 323 1E88
+p 77 323 1E89
+p 58 307 1E8A
+p 78 307 1E8B
+p 58 308 1E8C
+p 78 308 1E8D
+p 59 307 1E8E
+p 79 307 1E8F
+p 5A 302 1E90
+p 7A 302 1E91
+p 5A 323 1E92
+p 7A 323 1E93
+p 5A 331 1E94
+p 7A 331 1E95
+p 68 331 1E96
+p 74 308 1E97
+p 77 30A 1E98
+p 79 30A 1E99
+p 17F 307 1E9B
+p 41 323 1EA0
+p 61 323 1EA1
+p 41 309 1EA2
+p 61 309 1EA3
+p C2 301 1EA4
+p E2 301 1EA5
+p C2 300 1EA6
+p E2 300 1EA7
+p C2 309 1EA8
+p E2 309 1EA9
+p C2 303 1EAA
+p E2 303 1EAB
+p 1EA0 302 1EAC
+p 1EA1 302 1EAD
+p 102 301 1EAE
+p 103 301 1EAF
+p 102 300 1EB0
+p 103 300 1EB1
+p 102 309 1EB2
+p 103 309 1EB3
+p 102 303 1EB4
+p 103 303 1EB5
+p 1EA0 306 1EB6
+p 1EA1 306 1EB7
+p 45 323 1EB8
+p 65 323 1EB9
+p 45 309 1EBA
+p 65 309 1EBB
+p 45 303 1EBC
+p 65 303 1EBD
+p CA 301 1EBE
+p EA 301 1EBF
+p CA 300 1EC0
+p EA 300 1EC1
+p CA 309 1EC2
+p EA 309 1EC3
+p CA 303 1EC4
+p EA 303 1EC5
+p 1EB8 302 1EC6
+p 1EB9 302 1EC7
+p 49 309 1EC8
+p 69 309 1EC9
+p 49 323 1ECA
+p 69 323 1ECB
+p 4F 323 1ECC
+p 6F 323 1ECD
+p 4F 309 1ECE
+p 6F 309 1ECF
+p D4 301 1ED0
+p F4 301 1ED1
+p D4 300 1ED2
+p F4 300 1ED3
+p D4 309 1ED4
+p F4 309 1ED5
+p D4 303 1ED6
+p F4 303 1ED7
+p 1ECC 302 1ED8
+p 1ECD 302 1ED9
+p 1A0 301 1EDA
+p 1A1 301 1EDB
+p 1A0 300 1EDC
+p 1A1 300 1EDD
+p 1A0 309 1EDE
+p 1A1 309 1EDF
+p 1A0 303 1EE0
+p 1A1 303 1EE1
+p 1A0 323 1EE2
+p 1A1 323 1EE3
+p 55 323 1EE4
+p 75 323 1EE5
+p 55 309 1EE6
+p 75 309 1EE7
+p 1AF 301 1EE8
+p 1B0 301 1EE9
+p 1AF 300 1EEA
+p 1B0 300 1EEB
+p 1AF 309 1EEC
+p 1B0 309 1EED
+p 1AF 303 1EEE
+p 1B0 303 1EEF
+p 1AF 323 1EF0
+p 1B0 323 1EF1
+p 59 300 1EF2
+p 79 300 1EF3
+p 59 323 1EF4
+p 79 323 1EF5
+p 59 309 1EF6
+p 79 309 1EF7
+p 59 303 1EF8
+p 79 303 1EF9
+p 3B1 313 1F00
+p 3B1 314 1F01
+p 1F00 300 1F02
+p 1F01 300 1F03
+p 1F00 301 1F04
+p 1F01 301 1F05
+p 1F00 342 1F06
+p 1F01 342 1F07
+p 391 313 1F08
+p 391 314 1F09
+p 1F08 300 1F0A
+p 1F09 300 1F0B
+p 1F08 301 1F0C
+p 1F09 301 1F0D
+p 1F08 342 1F0E
+p 1F09 342 1F0F
+p 3B5 313 1F10
+p 3B5 314 1F11
+p 1F10 300 1F12
+p 1F11 300 1F13
+p 1F10 301 1F14
+p 1F11 301 1F15
+p 395 313 1F18
+p 395 314 1F19
+p 1F18 300 1F1A
+p 1F19 300 1F1B
+p 1F18 301 1F1C
+p 1F19 301 1F1D
+p 3B7 313 1F20
+p 3B7 314 1F21
+p 1F20 300 1F22
+p 1F21 300 1F23
+p 1F20 301 1F24
+p 1F21 301 1F25
+p 1F20 342 1F26
+p 1F21 342 1F27
+p 397 313 1F28
+p 397 314 1F29
+p 1F28 300 1F2A
+p 1F29 300 1F2B
+p 1F28 301 1F2C
+p 1F29 301 1F2D
+p 1F28 342 1F2E
+p 1F29 342 1F2F
+p 3B9 313 1F30
+p 3B9 314 1F31
+p 1F30 300 1F32
+p 1F31 300 1F33
+p 1F30 301 1F34
+p 1F31 301 1F35
+p 1F30 342 1F36
+p 1F31 342 1F37
+p 399 313 1F38
+p 399 314 1F39
+p 1F38 300 1F3A
+p 1F39 300 1F3B
+p 1F38 301 1F3C
+p 1F39 301 1F3D
+p 1F38 342 1F3E
+p 1F39 342 1F3F
+p 3BF 313 1F40
+p 3BF 314 1F41
+p 1F40 300 1F42
+p 1F41 300 1F43
+p 1F40 301 1F44
+p 1F41 301 1F45
+p 39F 313 1F48
+p 39F 314 1F49
+p 1F48 300 1F4A
+p 1F49 300 1F4B
+p 1F48 301 1F4C
+p 1F49 301 1F4D
+p 3C5 313 1F50
+p 3C5 314 1F51
+p 1F50 300 1F52
+p 1F51 300 1F53
+p 1F50 301 1F54
+p 1F51 301 1F55
+p 1F50 342 1F56
+p 1F51 342 1F57
+p 3A5 314 1F59
+p 1F59 300 1F5B
+p 1F59 301 1F5D
+p 1F59 342 1F5F
+p 3C9 313 1F60
+p 3C9 314 1F61
+p 1F60 300 1F62
+p 1F61 300 1F63
+p 1F60 301 1F64
+p 1F61 301 1F65
+p 1F60 342 1F66
+p 1F61 342 1F67
+p 3A9 313 1F68
+p 3A9 314 1F69
+p 1F68 300 1F6A
+p 1F69 300 1F6B
+p 1F68 301 1F6C
+p 1F69 301 1F6D
+p 1F68 342 1F6E
+p 1F69 342 1F6F
+p 3B1 300 1F70
+p 3B5 300 1F72
+p 3B7 300 1F74
+p 3B9 300 1F76
+p 3BF 300 1F78
+p 3C5 300 1F7A
+p 3C9 300 1F7C
+p 1F00 345 1F80
+p 1F01 345 1F81
+p 1F02 345 1F82
+p 1F03 345 1F83
+p 1F04 345 1F84
+p 1F05 345 1F85
+p 1F06 345 1F86
+p 1F07 345 1F87
+p 1F08 345 1F88
+p 1F09 345 1F89
+p 1F0A 345 1F8A
+p 1F0B 345 1F8B
+p 1F0C 345 1F8C
+p 1F0D 345 1F8D
+p 1F0E 345 1F8E
+p 1F0F 345 1F8F
+p 1F20 345 1F90
+p 1F21 345 1F91
+p 1F22 345 1F92
+p 1F23 345 1F93
+p 1F24 345 1F94
+p 1F25 345 1F95
+p 1F26 345 1F96
+p 1F27 345 1F97
+p 1F28 345 1F98
+p 1F29 345 1F99
+p 1F2A 345 1F9A
+p 1F2B 345 1F9B
+p 1F2C 345 1F9C
+p 1F2D 345 1F9D
+p 1F2E 345 1F9E
+p 1F2F 345 1F9F
+p 1F60 345 1FA0
+p 1F61 345 1FA1
+p 1F62 345 1FA2
+p 1F63 345 1FA3
+p 1F64 345 1FA4
+p 1F65 345 1FA5
+p 1F66 345 1FA6
+p 1F67 345 1FA7
+p 1F68 345 1FA8
+p 1F69 345 1FA9
+p 1F6A 345 1FAA
+p 1F6B 345 1FAB
+p 1F6C 345 1FAC
+p 1F6D 345 1FAD
+p 1F6E 345 1FAE
+p 1F6F 345 1FAF
+p 3B1 306 1FB0
+p 3B1 304 1FB1
+p 1F70 345 1FB2
+p 3B1 345 1FB3
+p 3AC 345 1FB4
+p 3B1 342 1FB6
+p 1FB6 345 1FB7
+p 391 306 1FB8
+p 391 304 1FB9
+p 391 300 1FBA
+p 391 345 1FBC
+p A8 342 1FC1
+p 1F74 345 1FC2
+p 3B7 345 1FC3
+p 3AE 345 1FC4
+p 3B7 342 1FC6
+p 1FC6 345 1FC7
+p 395 300 1FC8
+p 397 300 1FCA
+p 397 345 1FCC
+p 1FBF 300 1FCD
+p 1FBF 301 1FCE
+p 1FBF 342 1FCF
+p 3B9 306 1FD0
+p 3B9 304 1FD1
+p 3CA 300 1FD2
+p 3B9 342 1FD6
+p 3CA 342 1FD7
+p 399 306 1FD8
+p 399 304 1FD9
+p 399 300 1FDA
+p 1FFE 300 1FDD
+p 1FFE 301 1FDE
+p 1FFE 342 1FDF
+p 3C5 306 1FE0
+p 3C5 304 1FE1
+p 3CB 300 1FE2
+p 3C1 313 1FE4
+p 3C1 314 1FE5
+p 3C5 342 1FE6
+p 3CB 342 1FE7
+p 3A5 306 1FE8
+p 3A5 304 1FE9
+p 3A5 300 1FEA
+p 3A1 314 1FEC
+p A8 300 1FED
+p 1F7C 345 1FF2
+p 3C9 345 1FF3
+p 3CE 345 1FF4
+p 3C9 342 1FF6
+p 1FF6 345 1FF7
+p 39F 300 1FF8
+p 3A9 300 1FFA
+p 3A9 345 1FFC
+p 2190 338 219A
+p 2192 338 219B
+p 2194 338 21AE
+p 21D0 338 21CD
+p 21D4 338 21CE
+p 21D2 338 21CF
+p 2203 338 2204
+p 2208 338 2209
+p 220B 338 220C
+p 2223 338 2224
+p 2225 338 2226
+p 223C 338 2241
+p 2243 338 2244
+p 2245 338 2247
+p 2248 338 2249
+p 3D 338 2260
+p 2261 338 2262
+p 224D 338 226D
+p 3C 338 226E
+p 3E 338 226F
+p 2264 338 2270
+p 2265 338 2271
+p 2272 338 2274
+p 2273 338 2275
+p 2276 338 2278
+p 2277 338 2279
+p 227A 338 2280
+p 227B 338 2281
+p 2282 338 2284
+p 2283 338 2285
+p 2286 338 2288
+p 2287 338 2289
+p 22A2 338 22AC
+p 22A8 338 22AD
+p 22A9 338 22AE
+p 22AB 338 22AF
+p 227C 338 22E0
+p 227D 338 22E1
+p 2291 338 22E2
+p 2292 338 22E3
+p 22B2 338 22EA
+p 22B3 338 22EB
+p 22B4 338 22EC
+p 22B5 338 22ED
+p 304B 3099 304C
+p 304D 3099 304E
+p 304F 3099 3050
+p 3051 3099 3052
+p 3053 3099 3054
+p 3055 3099 3056
+p 3057 3099 3058
+p 3059 3099 305A
+p 305B 3099 305C
+p 305D 3099 305E
+p 305F 3099 3060
+p 3061 3099 3062
+p 3064 3099 3065
+p 3066 3099 3067
+p 3068 3099 3069
+p 306F 3099 3070
+p 306F 309A 3071
+p 3072 3099 3073
+p 3072 309A 3074
+p 3075 3099 3076
+p 3075 309A 3077
+p 3078 3099 3079
+p 3078 309A 307A
+p 307B 3099 307C
+p 307B 309A 307D
+p 3046 3099 3094
+p 309D 3099 309E
+p 30AB 3099 30AC
+p 30AD 3099 30AE
+p 30AF 3099 30B0
+p 30B1 3099 30B2
+p 30B3 3099 30B4
+p 30B5 3099 30B6
+p 30B7 3099 30B8
+p 30B9 3099 30BA
+p 30BB 3099 30BC
+p 30BD 3099 30BE
+p 30BF 3099 30C0
+p 30C1 3099 30C2
+p 30C4 3099 30C5
+p 30C6 3099 30C7
+p 30C8 3099 30C9
+p 30CF 3099 30D0
+p 30CF 309A 30D1
+p 30D2 3099 30D3
+p 30D2 309A 30D4
+p 30D5 3099 30D6
+p 30D5 309A 30D7
+p 30D8 3099 30D9
+p 30D8 309A 30DA
+p 30DB 3099 30DC
+p 30DB 309A 30DD
+p 30A6 3099 30F4
+p 30EF 3099 30F7
+p 30F0 3099 30F8
+p 30F1 3099 30F9
+p 30F2 3099 30FA
+p 30FD 3099 30FE
+p 11099 110BA 1109A
+p 1109B 110BA 1109C
+p 110A5 110BA 110AB
+p 11131 11127 1112E
+p 11132 11127 1112F
+p 11347 1133E 1134B
+p 11347 11357 1134C
+p 114B9 114BA 114BB
+p 114B9 114B0 114BC
+p 114B9 114BD 114BE
+p 115B8 115AF 115BA
+p 115B9 115AF 115BB
+p 11935 11930 11938
+`
@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestUnicodeForm(t *testing.T) {
+	for _, c := range []struct {
+		in, nfc, nfkc string
+	}{
+		{"plain ascii", "plain ascii", "plain ascii"},
+		{"cafe\u0301", "caf\u00e9", "caf\u00e9"},
+		{"caf\u00e9", "caf\u00e9", "caf\u00e9"},
+		{"\ufb01le", "\ufb01le", "file"},
+		{"\uff41\uff42", "\uff41\uff42", "ab"},
+		// Hangul jamo compose to syllables, and syllables stay
+		{"\u1100\u1161\u11a8", "\uac01", "\uac01"},
+		{"\uac01", "\uac01", "\uac01"},
+		// Marks are reordered by class before composing
+		{"a\u0301\u0323", "\u1ea1\u0301", "\u1ea1\u0301"},
+		{"\u212b", "\u00c5", "\u00c5"},
+		// Excluded compositions stay decomposed
+		{"\u0915\u093c", "\u0915\u093c", "\u0915\u093c"},
+		{"\u0958", "\u0915\u093c", "\u0915\u093c"},
+		// A leading mark composes with nothing
+		{"\u0301e", "\u0301e", "\u0301e"},
+		// Bytes that aren't UTF-8 are kept
+		{"e\u0301\xffe\u0301", "\u00e9\xff\u00e9", "\u00e9\xff\u00e9"},
+	} {
+		if got := (unicodeForm{}).String(c.in); got != c.nfc {
+			t.Errorf("NFC %+q = %+q, want %+q", c.in, got, c.nfc)
+		}
+		if got := (unicodeForm{compat: true}).String(c.in); got != c.nfkc {
+			t.Errorf("NFKC %+q = %+q, want %+q", c.in, got, c.nfkc)
+		}
+	}
+}
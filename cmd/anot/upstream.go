@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// upstream is one user configured DNS server, plain or DNS-over-HTTPS,
+// with its own rate limit
+type upstream struct {
+	name     string
+	resolver *net.Resolver
+	limit    *rateLimiter
+}
+
+// newUpstream builds an upstream from a resolver spec: an address such as
+// 1.1.1.1, 9.9.9.9:5353 or [2606:4700::1111]:53, or an https:// DoH URL
+func newUpstream(spec string, perSecond float64) (*upstream, error) {
+	u := &upstream{name: spec, limit: newRateLimiter(perSecond)}
+
+	if strings.HasPrefix(spec, "https://") {
+		u.resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return newDoHConn(spec), nil
+			},
+		}
+		return u, nil
+	}
+
+	addr := spec
+	if ip := net.ParseIP(addr); ip != nil {
+		addr = net.JoinHostPort(addr, "53")
+	} else if _, _, err := net.SplitHostPort(addr); err != nil {
+		if strings.Contains(addr, ":") {
+			return nil, fmt.Errorf("bad resolver %q", spec)
+		}
+		addr = net.JoinHostPort(addr, "53")
+	}
+	u.resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}
+	return u, nil
+}
+
+// readResolverFile reads one resolver spec per line, skipping blank and
+// comment lines
+func readResolverFile(fn string) ([]string, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var specs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			specs = append(specs, line)
+		}
+	}
+	return specs, scanner.Err()
+}
+
+// setUpstreams makes the client send its queries round-robin to the given
+// resolvers instead of the system one
+func (c *dnsClient) setUpstreams(specs []string, perSecond float64) error {
+	for _, spec := range specs {
+		u, err := newUpstream(spec, perSecond)
+		if err != nil {
+			return err
+		}
+		c.upstreams = append(c.upstreams, u)
+	}
+	return nil
+}
+
+// next returns the resolver for the next query, waiting for the global and
+// the resolver's rate limit.
+// Consecutive queries, retries of the same name included, go to different
+// upstreams, so an NXDOMAIN has to be confirmed by several of them.
+func (c *dnsClient) next(ctx context.Context) *net.Resolver {
+	netRate.wait(ctx)
+	if len(c.upstreams) == 0 {
+		return c.resolver
+	}
+	n := atomic.AddUint64(&c.turn, 1)
+	u := c.upstreams[(n-1)%uint64(len(c.upstreams))]
+	u.limit.wait(ctx)
+	return u.resolver
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"net/netip"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// defaultPorts are the ports canonical URLs leave out, per scheme
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// canonicalURL returns the canonical form of an absolute URL, the one
+// every equivalent spelling shares: scheme and host lowercased, the default
+// port left out and trailing slashes dropped, so http://A.example.com:80/
+// becomes http://a.example.com. With sortQuery the query parameters are
+// sorted too. It returns false for anything but an absolute URL.
+func canonicalURL(s string, sortQuery bool) (string, bool) {
+	if !strings.Contains(s, "://") {
+		return "", false
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil {
+		// Addresses in their canonical spelling, zones as they are
+		host = addr.String()
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + strings.Replace(host, "%", "%25", 1) + "]"
+	}
+	if port := u.Port(); port != "" && port != defaultPorts[scheme] {
+		host += ":" + port
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	b.WriteString(scheme)
+	b.WriteString("://")
+	if u.User != nil {
+		b.WriteString(u.User.String())
+		b.WriteByte('@')
+	}
+	b.WriteString(host)
+	b.WriteString(strings.TrimRight(u.EscapedPath(), "/"))
+	if query := u.RawQuery; query != "" {
+		if sortQuery {
+			params := strings.Split(query, "&")
+			sort.Strings(params)
+			query = strings.Join(params, "&")
+		}
+		b.WriteByte('?')
+		b.WriteString(query)
+	}
+	if u.Fragment != "" {
+		b.WriteByte('#')
+		b.WriteString(u.EscapedFragment())
+	}
+	return b.String(), true
+}
+
+// urlKeys wraps a keyFunc so the host of keys that are URLs is matched too
+// when it is an IP address, zoned or not, e.g. 10.0.0.0/8 removes
+// https://10.4.2.9:8443/,
+// or whatever it is with allHosts, e.g. a.example.com then removes
+// http://a.example.com:8080/login
+func urlKeys(keys keyFunc, allHosts bool) keyFunc {
+	return func(line string) []string {
+		base := keys(line)
+		out := base
+		for _, key := range base {
+			if host := urlHost(key); host != "" && (allHosts || isIPLiteral(host)) {
+				out = append(out, host)
+			}
+		}
+		return out
+	}
+}
+
+// isIPLiteral reports whether the host of a URL is an IP address, which
+// unlike isIPAddr may have a zone
+func isIPLiteral(host string) bool {
+	_, err := netip.ParseAddr(host)
+	return err == nil
+}
+
+// urlHost returns the host of an absolute URL, lowercased but for the zone
+// of an IPv6 address, its brackets left out, or ""
+func urlHost(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "://") {
+		return ""
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" {
+		return ""
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil {
+		return addr.String()
+	}
+	return strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/hasshido/anot"
+)
+
+// watchedFile is a target of "anot watch" and what it looked like when
+// last seen, so changes are noticed by polling
+type watchedFile struct {
+	fn      string
+	size    int64
+	modTime time.Time
+	// changed is when a change was last seen, pending until filtered
+	changed time.Time
+	pending bool
+}
+
+// stat returns the size and modification time of the file, zero when it
+// doesn't exist (yet)
+func (w *watchedFile) stat() (int64, time.Time) {
+	info, err := os.Stat(w.fn)
+	if err != nil {
+		return 0, time.Time{}
+	}
+	return info.Size(), info.ModTime()
+}
+
+// runWatch implements "anot watch": the target files are filtered in place
+// whenever they change, once they have been quiet for the debounce
+// interval, so lists other tools keep appending to stay scrubbed. They are
+// filtered again when the patterns change or SIGHUP asks for a reload.
+func runWatch(args []string) error {
+	fs := flag.NewFlagSet("anot watch", flag.ExitOnError)
+	var patternFiles stringList
+	var compiledFile string
+	var asnDBFile string
+	var trim bool
+	var extended bool
+	var quietMode bool
+	var interval time.Duration
+	var debounce time.Duration
+	var controlPath string
+	fs.Var(&patternFiles, "p", "read patterns from this file (repeatable)")
+	fs.StringVar(&compiledFile, "compiled", "", "load the patterns compiled by \"anot compile\" from this file")
+	fs.StringVar(&asnDBFile, "asn-db", "", "ASN database for asn:NUMBER patterns")
+	fs.BoolVar(&trim, "t", false, "trim leading and trailing whitespace before comparison")
+	fs.BoolVar(&extended, "x", false, "extended pattern syntax for the pattern files")
+	fs.BoolVar(&quietMode, "q", false, "don't report the lines removed")
+	fs.DurationVar(&interval, "interval", time.Second, "how often the files and the patterns are checked for changes")
+	fs.DurationVar(&debounce, "debounce", 2*time.Second, "how long a changed file must stay unchanged before it is filtered")
+	fs.StringVar(&controlPath, "control", "", "answer the commands of \"anot control\" on this unix socket")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: anot watch [options] -p patterns.txt filename...\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if interval <= 0 || debounce < 0 {
+		return usageError("-interval must be positive and -debounce can't be negative")
+	}
+
+	src := &patternSource{who: "anot watch", compiledFile: compiledFile, patternFiles: patternFiles,
+		asnDBFile: asnDBFile, extended: extended, trim: trim}
+	rules, matcher, err := src.load()
+	if err != nil {
+		return patternsError(err)
+	}
+	job := &filterJob{matcher: matcher, trim: trim, writeBuffer: defaultWriteBuffer}
+	var removed int
+	filter := func(w *watchedFile) {
+		n, err := job.filterInPlace(w)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s: %s\n", w.fn, err)
+		} else if n > 0 && !quietMode {
+			fmt.Fprintf(os.Stderr, "%s: removed %d lines\n", w.fn, n)
+		}
+		removed += n
+	}
+
+	// Every file is filtered once right away
+	var files []*watchedFile
+	for _, fn := range fs.Args() {
+		if _, err := repairFromJournal(fn); err != nil {
+			return ioError(fn, fmt.Errorf("failed to repair from journal: %s", err))
+		}
+		files = append(files, &watchedFile{fn: fn, pending: true})
+	}
+
+	// New patterns are picked up between passes, see reloadOn, and so are
+	// control commands
+	c := &watchControl{do: make(chan func()), src: src}
+	c.rescope = func(r []*anot.Rule, m *anot.Matcher) {
+		// The new scope applies to everything already there too
+		rules, job.matcher = r, m
+		for _, w := range files {
+			w.pending = true
+		}
+	}
+	src.reloadOn(interval, func(r []*anot.Rule, m *anot.Matcher) { c.run(func() { c.rescope(r, m) }) })
+	c.describe = func() string {
+		pending := 0
+		for _, w := range files {
+			if w.pending {
+				pending++
+			}
+		}
+		return fmt.Sprintf("patterns=%d files=%d pending=%d removed=%d", len(rules), len(files), pending, removed)
+	}
+	c.current = func() []*anot.Rule { return rules }
+	c.drainFiles = func() {
+		for _, w := range files {
+			if size, modTime := w.stat(); size != w.size || !modTime.Equal(w.modTime) {
+				w.size, w.modTime, w.pending = size, modTime, true
+			}
+			if w.pending {
+				filter(w)
+			}
+		}
+	}
+	stopped := make(chan struct{}, 1)
+	if controlPath != "" {
+		ctl, err := listenUnix(controlPath, "another anot")
+		if err != nil {
+			return ioError(controlPath, err)
+		}
+		defer ctl.Close()
+		go serveControl(ctl, c, extended, trim, stopped)
+	}
+
+	// Stop between passes, never halfway through writing a file
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		for _, w := range files {
+			if size, modTime := w.stat(); size != w.size || !modTime.Equal(w.modTime) {
+				w.size, w.modTime = size, modTime
+				w.changed, w.pending = now, true
+			}
+			if !w.pending || now.Sub(w.changed) < debounce {
+				continue
+			}
+			filter(w)
+		}
+		select {
+		case <-ticker.C:
+		case f := <-c.do:
+			f()
+		case <-stopped:
+			return nil
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// watchControl drives "anot watch" from its control socket. Everything
+// runs on the goroutine of the watch loop, between passes, through do.
+type watchControl struct {
+	do  chan func()
+	src *patternSource
+
+	// rescope puts patterns to use, describe returns the status, current
+	// the patterns in use and drainFiles filters every changed file
+	rescope    func([]*anot.Rule, *anot.Matcher)
+	describe   func() string
+	current    func() []*anot.Rule
+	drainFiles func()
+}
+
+// run runs f on the watch loop and waits for it
+func (c *watchControl) run(f func()) {
+	done := make(chan struct{})
+	c.do <- func() {
+		f()
+		close(done)
+	}
+	<-done
+}
+
+// filterInPlace filters a watched file, rewriting it only if lines were
+// removed or changed, and returns how many were removed. When the file
+// changes while it is read, it is left alone and stays pending rather than
+// losing the lines appended meanwhile. It is rewritten in place, as the
+// tools appending to it keep it open, and lines appended after it was read
+// are carried over.
+func (j *filterJob) filterInPlace(w *watchedFile) (int, error) {
+	size, modTime := w.stat()
+	if size == 0 && modTime.IsZero() {
+		// Not there (yet), nothing to filter
+		w.pending = false
+		return 0, nil
+	}
+	lines, err := readLines(w.fn)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file: %s", err)
+	}
+
+	filtered := make([]string, 0, len(lines))
+	changed := false
+	for _, line := range lines {
+		out, keep := j.filterLine(line, j.keys)
+		if keep {
+			filtered = append(filtered, out)
+		}
+		changed = changed || !keep || out != line
+	}
+
+	if now, nowMod := w.stat(); now != size || !nowMod.Equal(modTime) {
+		// Written to meanwhile, go again once it is quiet
+		return 0, nil
+	}
+	w.size, w.modTime, w.pending = size, modTime, false
+	if !changed {
+		return 0, nil
+	}
+	if err := writeLinesKeepingTail(w.fn, filtered, j.writeBuffer, size); err != nil {
+		// Try again after another debounce interval
+		w.changed, w.pending = time.Now(), true
+		return 0, fmt.Errorf("failed to write file: %s", err)
+	}
+	// Our own write is not a change to react to, lines carried over are
+	w.size, w.modTime = w.stat()
+	written := int64(0)
+	for _, line := range filtered {
+		written += int64(len(line)) + 1
+	}
+	if w.size != written {
+		w.changed, w.pending = time.Now(), true
+	}
+	return len(lines) - len(filtered), nil
+}
+
+func (c *watchControl) status() string {
+	var status string
+	c.run(func() { status = c.describe() })
+	return status
+}
+
+func (c *watchControl) reload() (int, error) {
+	rules, m, err := c.src.load()
+	if err != nil {
+		return 0, err
+	}
+	c.run(func() { c.rescope(rules, m) })
+	fmt.Fprintf(os.Stderr, "reloaded %d patterns\n", len(rules))
+	return len(rules), nil
+}
+
+func (c *watchControl) addPatterns(added []*anot.Rule) (int, error) {
+	var n int
+	var err error
+	c.run(func() {
+		rules := append(append([]*anot.Rule(nil), c.current()...), added...)
+		var m *anot.Matcher
+		if m, err = c.src.compile(rules); err == nil {
+			c.rescope(rules, m)
+			n = len(rules)
+		}
+	})
+	return n, err
+}
+
+// drain filters every file changed since its last pass without waiting
+// for the debounce interval
+func (c *watchControl) drain() {
+	c.run(c.drainFiles)
+}
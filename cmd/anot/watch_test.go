@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestFilterInPlaceKeepsAppenders(t *testing.T) {
+	rules, err := anot.ReadRules(strings.NewReader("b\n"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job := &filterJob{matcher: anot.NewMatcher(rules), writeBuffer: defaultWriteBuffer}
+
+	fn := filepath.Join(t.TempDir(), "hosts.txt")
+	if err := os.WriteFile(fn, []byte("a\nb\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// An appender holding the file open, like a shell's >>
+	appender, err := os.OpenFile(fn, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer appender.Close()
+	before, err := os.Stat(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := &watchedFile{fn: fn, pending: true}
+	if n, err := job.filterInPlace(w); err != nil || n != 1 {
+		t.Fatalf("first pass removed %d lines, err %v", n, err)
+	}
+	if _, err := appender.WriteString("c\nb\n"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := job.filterInPlace(w); err != nil || n != 1 {
+		t.Fatalf("second pass removed %d lines, err %v", n, err)
+	}
+
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "a\nc\n" {
+		t.Errorf("file is %q, want %q", data, "a\nc\n")
+	}
+	after, err := os.Stat(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(before, after) {
+		t.Error("the file was replaced rather than rewritten")
+	}
+}
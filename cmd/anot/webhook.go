@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// webhookSamples is how many of the removed lines a notification carries
+const webhookSamples = 10
+
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// removalNotice is what --webhook receives for every file a run removed
+// lines from; text makes it readable as is by Slack style incoming webhooks
+type removalNotice struct {
+	Text    string   `json:"text"`
+	File    string   `json:"file"`
+	Removed int      `json:"removed"`
+	Kept    int      `json:"kept"`
+	Sample  []string `json:"sample"`
+	DryRun  bool     `json:"dry_run,omitempty"`
+}
+
+// notifyRemovals posts a removalNotice to the webhook, if any and if lines
+// were removed. A failed notification is only a warning, the file is
+// filtered either way.
+func (j *filterJob) notifyRemovals(fn string, removed, kept int, sample []string, stderr io.Writer) {
+	if j.webhook == "" || removed == 0 {
+		return
+	}
+	if len(sample) > webhookSamples {
+		sample = sample[:webhookSamples]
+	}
+	n := removalNotice{
+		Text:    fmt.Sprintf("anot removed %d of %d lines from %s", removed, removed+kept, fn),
+		File:    fn,
+		Removed: removed,
+		Kept:    kept,
+		Sample:  sample,
+		DryRun:  j.dryRun,
+	}
+	if j.dryRun {
+		n.Text = fmt.Sprintf("anot would remove %d of %d lines from %s", removed, removed+kept, fn)
+	}
+	body, err := json.Marshal(n)
+	if err == nil {
+		err = postJSON(j.webhook, body)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "warning: %s: webhook notification failed: %s\n", fn, err)
+	}
+}
+
+func postJSON(url string, body []byte) error {
+	resp, err := webhookClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hasshido/anot"
+)
+
+// cleaning says what of the junk copy-pasted lists carry to clean up from
+// lines and patterns alike before they are compared
+type cleaning struct {
+	// form, when set, is the Unicode normalization form applied first, so
+	// differently composed spellings of the same text compare equal
+	form *unicodeForm
+	// squeeze collapses every run of whitespace into one space
+	squeeze bool
+	// tabs turns tabs and Unicode spaces such as NBSP into plain spaces
+	tabs bool
+	// invisible drops zero-width and other invisible format characters
+	invisible bool
+	// refang undoes the defanging of indicators, hxxp:// and 1.2.3[.]4
+	refang bool
+	// urls rewrites URLs to their canonical form, with the query
+	// parameters sorted when sortQuery is set
+	urls      bool
+	sortQuery bool
+	// anyScheme drops the scheme of canonical URLs, so any scheme matches
+	anyScheme bool
+}
+
+// cleaner returns the function cleaning up a string, nil when there is
+// nothing to clean
+func (c cleaning) cleaner() func(string) string {
+	if c.form == nil && !c.squeeze && !c.tabs && !c.invisible && !c.refang && !c.urls {
+		return nil
+	}
+	return c.clean
+}
+
+func (c cleaning) clean(s string) string {
+	if c.form != nil {
+		s = c.form.String(s)
+	}
+	if !c.plain(s) {
+		s = c.cleanSpace(s)
+	}
+	if c.refang {
+		s = refang(s)
+	}
+	if c.urls {
+		if u, ok := canonicalURL(s, c.sortQuery); ok {
+			if c.anyScheme {
+				u = u[strings.Index(u, "://")+3:]
+			}
+			return u
+		}
+	}
+	return s
+}
+
+// setForm sets the Unicode normalization form, nfc or nfkc, for -unicode
+func (c *cleaning) setForm(name string) error {
+	var form unicodeForm
+	switch strings.ToLower(name) {
+	case "nfc":
+	case "nfkc":
+		form.compat = true
+	default:
+		return fmt.Errorf("unknown Unicode normalization %q (want nfc or nfkc)", name)
+	}
+	c.form = &form
+	return nil
+}
+
+// cleanSpace cleans up the whitespace and invisible characters of s
+func (c cleaning) cleanSpace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		switch {
+		case c.invisible && unicode.Is(unicode.Cf, r):
+			continue
+		case c.squeeze && unicode.IsSpace(r):
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		case c.tabs && r != ' ' && r != '\n' && unicode.IsSpace(r):
+			r = ' '
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// plain reports whether s has no whitespace or invisible characters to
+// clean up, as most lines don't
+func (c cleaning) plain(s string) bool {
+	if !c.squeeze && !c.tabs && !c.invisible {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		switch b := s[i]; {
+		case b >= 0x80, b == '\t':
+			return false
+		case !c.squeeze:
+		case b == ' ' && i > 0 && s[i-1] == ' ', b == '\n', b == '\v', b == '\f', b == '\r':
+			return false
+		}
+	}
+	return true
+}
+
+// refanger undoes the usual ways threat-intel exports defang indicators
+var refanger = strings.NewReplacer(
+	"hxxp", "http", "hXXp", "http", "HXXP", "HTTP",
+	"[://]", "://", "[:]", ":",
+	"[.]", ".", "(.)", ".", "{.}", ".", "[dot]", ".", "(dot)", ".",
+	"[@]", "@", "[at]", "@", "(at)", "@",
+)
+
+// refang returns s with the defanging of indicators undone, so
+// hxxps://example[.]com becomes https://example.com
+func refang(s string) string {
+	if !strings.ContainsAny(s, "[({xX") {
+		return s
+	}
+	return refanger.Replace(s)
+}
+
+// cleanRules cleans up the patterns of rules in place
+func cleanRules(rules []*anot.Rule, clean func(string) string) {
+	if clean == nil {
+		return
+	}
+	for _, rule := range rules {
+		rule.Pattern = clean(rule.Pattern)
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hasshido/anot"
+)
+
+// rdapURL is the RDAP bootstrap service, redirecting to the registry
+// responsible for an address
+var rdapURL = "https://rdap.org/ip/"
+
+// whoisPattern is a whois-org:VALUE pattern
+type whoisPattern struct {
+	org  string
+	rule *anot.Rule
+}
+
+// splitWhoisPatterns takes the whois-org: patterns out of the rules
+func splitWhoisPatterns(rules []*anot.Rule) ([]*anot.Rule, []*whoisPattern) {
+	var rest []*anot.Rule
+	var patterns []*whoisPattern
+	for _, rule := range rules {
+		v, ok := cutPrefixFold(rule.Pattern, "whois-org:")
+		if !ok {
+			rest = append(rest, rule)
+			continue
+		}
+		v = strings.Trim(v, `"`)
+		if v != "" {
+			patterns = append(patterns, &whoisPattern{org: strings.ToLower(v), rule: rule})
+		}
+	}
+	return rest, patterns
+}
+
+// cutPrefixFold is strings.CutPrefix ignoring case
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// rdapNetwork is the registered network an RDAP answer describes
+type rdapNetwork struct {
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+	Orgs  []string `json:"orgs"`
+
+	start, end u128
+}
+
+// whoisCheck removes IPs whose RDAP registration names an organization
+// containing one of the patterns (case-insensitive). The networks already
+// looked up are remembered, so a range is asked about only once.
+type whoisCheck struct {
+	patterns []*whoisPattern
+	cache    *diskCache
+
+	mu       sync.Mutex
+	networks []*rdapNetwork
+}
+
+func (w *whoisCheck) wants(key string) bool {
+	return net.ParseIP(key) != nil
+}
+
+func (w *whoisCheck) check(ctx context.Context, key string) *anot.Rule {
+	n := w.network(ctx, net.ParseIP(key))
+	if n == nil {
+		return nil
+	}
+	for _, p := range w.patterns {
+		for _, org := range n.Orgs {
+			if strings.Contains(strings.ToLower(org), p.org) {
+				return p.rule
+			}
+		}
+	}
+	return nil
+}
+
+// network returns the registered network containing ip, or nil when the
+// lookup failed
+func (w *whoisCheck) network(ctx context.Context, ip net.IP) *rdapNetwork {
+	a := ipToU128(ip)
+	w.mu.Lock()
+	for _, n := range w.networks {
+		if !a.less(n.start) && !n.end.less(a) {
+			w.mu.Unlock()
+			return n
+		}
+	}
+	w.mu.Unlock()
+
+	n := &rdapNetwork{}
+	if !w.cache.get("rdap:"+ip.String(), n) {
+		var err error
+		if n, err = lookupRDAP(ctx, ip); err != nil {
+			return nil
+		}
+		w.cache.put("rdap:"+ip.String(), n)
+	}
+
+	start, end := net.ParseIP(n.Start), net.ParseIP(n.End)
+	if start != nil && end != nil {
+		n.start, n.end = ipToU128(start), ipToU128(end)
+		w.mu.Lock()
+		w.networks = append(w.networks, n)
+		w.mu.Unlock()
+	}
+	return n
+}
+
+// rdapEntity is the part of an RDAP entity naming an organization
+type rdapEntity struct {
+	Roles      []string        `json:"roles"`
+	VCardArray json.RawMessage `json:"vcardArray"`
+}
+
+// lookupRDAP queries the registration of the network containing ip
+func lookupRDAP(ctx context.Context, ip net.IP) (*rdapNetwork, error) {
+	netRate.wait(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rdapURL+ip.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rdap+json")
+	resp, err := enrichClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rdap %s: %s", ip, resp.Status)
+	}
+
+	var doc struct {
+		StartAddress string       `json:"startAddress"`
+		EndAddress   string       `json:"endAddress"`
+		Name         string       `json:"name"`
+		Entities     []rdapEntity `json:"entities"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	n := &rdapNetwork{Start: doc.StartAddress, End: doc.EndAddress}
+	if doc.Name != "" {
+		n.Orgs = append(n.Orgs, doc.Name)
+	}
+	for _, e := range doc.Entities {
+		if fn := vcardName(e.VCardArray); fn != "" {
+			n.Orgs = append(n.Orgs, fn)
+		}
+	}
+	return n, nil
+}
+
+// vcardName returns the fn (formatted name) of a jCard:
+//
+//	["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Name"], ...]]
+func vcardName(raw json.RawMessage) string {
+	var card []json.RawMessage
+	if json.Unmarshal(raw, &card) != nil || len(card) != 2 {
+		return ""
+	}
+	var props [][]interface{}
+	if json.Unmarshal(card[1], &props) != nil {
+		return ""
+	}
+	for _, prop := range props {
+		if len(prop) >= 4 && prop[0] == "fn" {
+			name, _ := prop[3].(string)
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hasshido/anot"
+)
+
+func TestVcardName(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"fn", `["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Example Hosting"]]]`, "Example Hosting"},
+		{"no fn", `["vcard", [["version", {}, "text", "4.0"]]]`, ""},
+		{"not a jcard", `{"fn": "x"}`, ""},
+		{"empty", ``, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vcardName([]byte(tt.raw)); got != tt.want {
+				t.Errorf("vcardName = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhoisCheck(t *testing.T) {
+	var queries int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&queries, 1)
+		switch r.URL.Path {
+		case "/ip/192.0.2.1", "/ip/192.0.2.2":
+			w.Write([]byte(`{"startAddress": "192.0.2.0", "endAddress": "192.0.2.255", "name": "EXAMPLE-NET",
+				"entities": [{"roles": ["registrant"], "vcardArray": ["vcard", [["fn", {}, "text", "Example Hosting Ltd"]]]}]}`))
+		case "/ip/198.51.100.1":
+			w.Write([]byte(`{"startAddress": "198.51.100.0", "endAddress": "198.51.100.255", "name": "OTHER-NET"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	defer func(url string) { rdapURL = url }(rdapURL)
+	rdapURL = srv.URL + "/ip/"
+
+	rules, err := anot.ReadRules(strings.NewReader("whois-org:\"example hosting\"\nexample.com\n"), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rest, patterns := splitWhoisPatterns(rules)
+	if len(rest) != 1 || rest[0].Pattern != "example.com" || len(patterns) != 1 || patterns[0].org != "example hosting" {
+		t.Fatalf("split into %v and %v", rest, patterns)
+	}
+	w := &whoisCheck{patterns: patterns}
+
+	tests := []struct {
+		key     string
+		removed bool
+	}{
+		{"192.0.2.1", true},
+		{"192.0.2.2", true},
+		{"198.51.100.1", false},
+		{"203.0.113.1", false},
+	}
+	for _, tt := range tests {
+		if !w.wants(tt.key) {
+			t.Errorf("%s not wanted", tt.key)
+		}
+		if got := w.check(context.Background(), tt.key) != nil; got != tt.removed {
+			t.Errorf("check(%s) removed = %v, want %v", tt.key, got, tt.removed)
+		}
+	}
+	// 192.0.2.2 is in the network already looked up for 192.0.2.1
+	if n := atomic.LoadInt32(&queries); n != 3 {
+		t.Errorf("%d RDAP queries, want 3", n)
+	}
+	if w.wants("example.com") {
+		t.Error("a name is wanted")
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/hasshido/anot"
+)
+
+// wildcardProbes is the number of random labels resolved per parent zone;
+// every one of them has to resolve for the zone to count as a wildcard
+const wildcardProbes = 3
+
+// wildcardRule is the rule reported for names only resolving via wildcard DNS
+var wildcardRule = &anot.Rule{Pattern: "wildcard DNS", Action: anot.ActionRemove}
+
+// wildcardZone is the probed wildcard answer of one parent zone
+type wildcardZone struct {
+	once sync.Once
+	// addrs holds every address the random labels resolved to, nil when
+	// the zone has no wildcard
+	addrs map[string]bool
+}
+
+// wildcardCheck removes subdomains that only resolve because their parent
+// zone has a wildcard record, the usual noise in bruteforce output. A name
+// goes when all of its addresses are among the ones random labels of the
+// same parent resolve to.
+type wildcardCheck struct {
+	dns *dnsClient
+
+	mu    sync.Mutex
+	zones map[string]*wildcardZone
+}
+
+func newWildcardCheck(dns *dnsClient) *wildcardCheck {
+	return &wildcardCheck{dns: dns, zones: make(map[string]*wildcardZone)}
+}
+
+func (w *wildcardCheck) wants(key string) bool {
+	return looksLikeDomain(key) && !strings.HasPrefix(key, "*.") && strings.Count(key, ".") >= 2
+}
+
+func (w *wildcardCheck) check(ctx context.Context, key string) *anot.Rule {
+	name := strings.TrimSuffix(strings.ToLower(key), ".")
+	_, parent, _ := strings.Cut(name, ".")
+
+	wildcard := w.zone(ctx, parent)
+	if wildcard == nil {
+		return nil
+	}
+	addrs, err := w.dns.lookupIP(ctx, name)
+	if err != nil || len(addrs) == 0 {
+		return nil
+	}
+	for _, ip := range addrs {
+		if !wildcard[ip.String()] {
+			return nil
+		}
+	}
+	return wildcardRule
+}
+
+// zone returns the wildcard answer set of a parent zone, probing it on
+// first use
+func (w *wildcardCheck) zone(ctx context.Context, parent string) map[string]bool {
+	w.mu.Lock()
+	z, ok := w.zones[parent]
+	if !ok {
+		z = &wildcardZone{}
+		w.zones[parent] = z
+	}
+	w.mu.Unlock()
+
+	z.once.Do(func() {
+		addrs := make(map[string]bool)
+		for i := 0; i < wildcardProbes; i++ {
+			ips, err := w.dns.lookupIP(ctx, randomLabel()+"."+parent)
+			if err != nil || len(ips) == 0 {
+				return
+			}
+			for _, ip := range ips {
+				addrs[ip.String()] = true
+			}
+		}
+		z.addrs = addrs
+	})
+	return z.addrs
+}
+
+// randomLabel returns a DNS label that is all but certain not to exist
+func randomLabel() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return "anot-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"strings"
+)
+
+// zoneParenDepth returns the change of parenthesis depth on a zone file
+// line, ignoring quoted strings and ";" comments
+func zoneParenDepth(line string) int {
+	depth := 0
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';':
+			return depth
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		}
+	}
+	return depth
+}
+
+// groupZoneRecords joins lines of parenthesized multi-line resource
+// records (typically the SOA) into single entries, keeping the raw text
+func groupZoneRecords(lines []string) []string {
+	var records []string
+	var pending []string
+	depth := 0
+	for _, line := range lines {
+		pending = append(pending, line)
+		depth += zoneParenDepth(line)
+		if depth <= 0 {
+			records = append(records, strings.Join(pending, "\n"))
+			pending = pending[:0]
+			depth = 0
+		}
+	}
+	if len(pending) > 0 {
+		records = append(records, strings.Join(pending, "\n"))
+	}
+	return records
+}
+
+// zoneRecordTypes lists the record types recognised when telling the TTL
+// and class fields apart from the type of a record
+var zoneRecordTypes = map[string]bool{
+	"A": true, "AAAA": true, "AFSDB": true, "APL": true, "CAA": true, "CDNSKEY": true, "CDS": true, "CERT": true,
+	"CNAME": true, "CSYNC": true, "DHCID": true, "DLV": true, "DNAME": true, "DNSKEY": true, "DS": true, "EUI48": true,
+	"EUI64": true, "HINFO": true, "HIP": true, "HTTPS": true, "IPSECKEY": true, "KEY": true, "KX": true, "LOC": true,
+	"MX": true, "NAPTR": true, "NS": true, "NSEC": true, "NSEC3": true, "NSEC3PARAM": true, "OPENPGPKEY": true,
+	"PTR": true, "RP": true, "RRSIG": true, "SIG": true, "SMIMEA": true, "SOA": true, "SPF": true, "SRV": true,
+	"SSHFP": true, "SVCB": true, "TA": true, "TKEY": true, "TLSA": true, "TSIG": true, "TXT": true, "URI": true,
+	"ZONEMD": true,
+}
+
+// zoneOwners resolves the owner name of every record of a zone file,
+// following $ORIGIN directives, "@" and blank owners that repeat the
+// previous one. Directives, comments, blank lines and the SOA record get an
+// empty owner so they are never removed. Names are lower-cased and
+// returned without the trailing dot.
+func zoneOwners(records []string, origin string) []string {
+	origin = strings.TrimSuffix(origin, ".")
+	owners := make([]string, len(records))
+	last := ""
+	for i, record := range records {
+		body := record
+		if j := strings.Index(body, ";"); j >= 0 && !strings.Contains(body[:j], `"`) {
+			body = body[:j]
+		}
+		fields := strings.Fields(body)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], "$") {
+			if strings.EqualFold(fields[0], "$ORIGIN") && len(fields) > 1 {
+				origin = strings.TrimSuffix(fields[1], ".")
+			}
+			continue
+		}
+
+		// A record starting with whitespace reuses the previous owner
+		owner := last
+		rest := fields
+		if record[0] != ' ' && record[0] != '\t' {
+			owner = absoluteZoneName(fields[0], origin)
+			rest = fields[1:]
+		}
+		last = owner
+
+		if zoneRecordType(rest) == "SOA" {
+			continue
+		}
+		owners[i] = strings.ToLower(owner)
+	}
+	return owners
+}
+
+// absoluteZoneName qualifies a possibly relative owner name with the origin
+func absoluteZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	}
+	return name + "." + origin
+}
+
+// zoneRecordType picks the type out of the [TTL] [CLASS] TYPE prefix
+func zoneRecordType(fields []string) string {
+	for _, f := range fields {
+		if up := strings.ToUpper(f); zoneRecordTypes[up] || strings.HasPrefix(up, "TYPE") {
+			return up
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGroupZoneRecords(t *testing.T) {
+	lines := []string{
+		"@ IN SOA ns1 hostmaster (",
+		"  2024010101 ; serial (not a paren)",
+		"  3600 900 604800 86400 )",
+		`txt IN TXT "(" ; still one line`,
+		"www IN A 10.0.0.1",
+	}
+	want := []string{
+		strings.Join(lines[:3], "\n"),
+		lines[3],
+		lines[4],
+	}
+	if got := groupZoneRecords(lines); !reflect.DeepEqual(got, want) {
+		t.Errorf("groupZoneRecords = %q, want %q", got, want)
+	}
+}
+
+func TestZoneOwners(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []string
+		origin  string
+		want    []string
+	}{
+		{"relative and absolute",
+			[]string{"www IN A 10.0.0.1", "Mail.Example.Org. 300 IN A 10.0.0.2"}, "example.com.",
+			[]string{"www.example.com", "mail.example.org"}},
+		{"at sign", []string{"@ IN MX 10 mail"}, "example.com", []string{"example.com"}},
+		{"blank owner repeats", []string{"www IN A 10.0.0.1", "  IN AAAA 2001:db8::1"}, "example.com",
+			[]string{"www.example.com", "www.example.com"}},
+		{"origin directive", []string{"$ORIGIN lab.example.com.", "host A 10.0.0.3"}, "example.com",
+			[]string{"", "host.lab.example.com"}},
+		{"other directive", []string{"$TTL 3600", "; comment", ""}, "example.com", []string{"", "", ""}},
+		{"soa kept", []string{"@ 3600 IN SOA ns1 hostmaster ( 1 2 3 4 5 )", "ns1 IN A 10.0.0.53"}, "example.com",
+			[]string{"", "ns1.example.com"}},
+		{"generic type", []string{"odd 60 IN TYPE65534 \\# 0"}, "example.com", []string{"odd.example.com"}},
+		{"no origin", []string{"www A 10.0.0.1"}, "", []string{"www"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zoneOwners(tt.records, tt.origin); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("zoneOwners = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package anot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// CompiledVersion changes whenever the compiled format or the matching
+// semantics change, invalidating older compiled files
+const CompiledVersion = 1
+
+// CompiledPatterns is the serialized form of a Matcher: the pre-built
+// lookup structures, referencing rules by their index in the pattern list.
+// Loading it skips classifying and parsing every pattern.
+type CompiledPatterns struct {
+	Version int
+	// Hash identifies the rules the matcher was built from
+	Hash string
+	// Rules are only stored when the patterns aren't available otherwise
+	Rules []Rule
+
+	Exact  []int32
+	MACs   map[string]int32
+	OUIs   map[string]int32
+	ASNs   map[uint32]int32
+	Labels []compiledLabel
+	CIDR4  []int32
+	CIDR6  []int32
+}
+
+// compiledLabel is a wildcard trie node; node 0 is the root
+type compiledLabel struct {
+	Parent int32
+	Label  string
+	// Rule is the index of the node's rule, or -1
+	Rule int32
+}
+
+// RulesHash returns a hash identifying a rule list, patterns, actions and
+// order included
+func RulesHash(rules []*Rule) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "anot compiled v%d\n", CompiledVersion)
+	buf := make([]byte, 0, 256)
+	for _, r := range rules {
+		buf = append(buf[:0], r.Pattern...)
+		buf = append(buf, 0)
+		buf = strconv.AppendInt(buf, int64(r.Action), 10)
+		buf = append(buf, 0)
+		buf = append(buf, r.Arg...)
+		buf = append(buf, '\n')
+		h.Write(buf)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Compile flattens a matcher built from rules. Trie nodes already
+// record the index of their rule; the maps are indexed by finding which
+// rule each pattern registered.
+func Compile(rules []*Rule, m *Matcher) *CompiledPatterns {
+	c := &CompiledPatterns{
+		Version: CompiledVersion,
+		Hash:    RulesHash(rules),
+		Exact:   make([]int32, 0, len(m.exactMatches)),
+		MACs:    make(map[string]int32, len(m.macs)),
+		OUIs:    make(map[string]int32, len(m.ouis)),
+		ASNs:    make(map[uint32]int32, len(m.asns)),
+	}
+	for i, r := range rules {
+		if m.exactMatches[r.Pattern] == r {
+			c.Exact = append(c.Exact, int32(i))
+		} else if zoned, ok := zonedIP(r.Pattern); ok && m.exactMatches[zoned] == r {
+			c.Exact = append(c.Exact, int32(i))
+		} else if m.ips != nil {
+			// Rules are shared in the IP set; an equivalent duplicate
+			// listed as well is dropped again when loading
+			if addr, ok := canonicalIP(r.Pattern); ok && m.ips.has(addr, r) {
+				c.Exact = append(c.Exact, int32(i))
+			}
+		}
+		if len(m.macs) > 0 {
+			if mac := normalizeMAC(r.Pattern); mac != "" && m.macs[mac] == r {
+				c.MACs[mac] = int32(i)
+			}
+		}
+		if len(m.ouis) > 0 {
+			if oui := normalizeOUI(r.Pattern); oui != "" && m.ouis[oui] == r {
+				c.OUIs[oui] = int32(i)
+			}
+		}
+		if len(m.asns) > 0 {
+			if asn, ok := parseASNPattern(r.Pattern); ok && m.asns[asn] == r {
+				c.ASNs[asn] = int32(i)
+			}
+		}
+	}
+
+	ruleIndex := func(rule *Rule, order int) int32 {
+		if rule == nil {
+			return -1
+		}
+		return int32(order)
+	}
+
+	if m.wildcards != nil {
+		type queued struct {
+			node   *labelNode
+			parent int32
+			label  string
+		}
+		queue := []queued{{node: m.wildcards, parent: -1}}
+		for len(queue) > 0 {
+			q := queue[0]
+			queue = queue[1:]
+			id := int32(len(c.Labels))
+			c.Labels = append(c.Labels, compiledLabel{Parent: q.parent, Label: q.label, Rule: ruleIndex(q.node.rule, q.node.order)})
+			for label, child := range q.node.children {
+				queue = append(queue, queued{node: child, parent: id, label: label})
+			}
+		}
+	}
+
+	if m.cidrMatcher != nil {
+		c.CIDR4 = compileCIDRTrie(m.cidrMatcher.v4, ruleIndex)
+		c.CIDR6 = compileCIDRTrie(m.cidrMatcher.v6, ruleIndex)
+	}
+	return c
+}
+
+// compileCIDRTrie flattens a CIDR trie in depth-first order, as a zero
+// child, a one child and a rule index per node. Node 0 is the root, so a
+// child index of 0 means no child.
+func compileCIDRTrie(root *cidrNode, ruleIndex func(*Rule, int) int32) []int32 {
+	var nodes []int32
+	var walk func(n *cidrNode) int32
+	walk = func(n *cidrNode) int32 {
+		id := int32(len(nodes) / 3)
+		nodes = append(nodes, 0, 0, ruleIndex(n.rule, n.order))
+		for bit, child := range n.child {
+			if child != nil {
+				childID := walk(child)
+				nodes[int(id)*3+bit] = childID
+			}
+		}
+		return id
+	}
+	walk(root)
+	return nodes
+}
+
+// KeepRules stores the pattern rules along with the matcher, which is
+// compiled from them or a subset of them
+func (c *CompiledPatterns) KeepRules(rules []*Rule) {
+	c.Rules = make([]Rule, len(rules))
+	for i, r := range rules {
+		c.Rules[i] = *r
+	}
+}
+
+// StoredRules returns the pattern rules KeepRules stored
+func (c *CompiledPatterns) StoredRules() []*Rule {
+	rules := make([]*Rule, len(c.Rules))
+	for i := range c.Rules {
+		rules[i] = &c.Rules[i]
+	}
+	return rules
+}
+
+// Matcher rebuilds the Matcher from the compiled form, given the rules it
+// was compiled from
+func (c *CompiledPatterns) Matcher(rules []*Rule) (*Matcher, error) {
+	if c.Version != CompiledVersion {
+		return nil, fmt.Errorf("compiled with format version %d, want %d", c.Version, CompiledVersion)
+	}
+	rule := func(i int32) (*Rule, error) {
+		if i < 0 || int(i) >= len(rules) {
+			return nil, fmt.Errorf("bad rule index %d", i)
+		}
+		return rules[i], nil
+	}
+
+	m := &Matcher{
+		exactMatches: make(map[string]*Rule, len(c.Exact)),
+		macs:         make(map[string]*Rule, len(c.MACs)),
+		ouis:         make(map[string]*Rule, len(c.OUIs)),
+		asns:         make(map[uint32]*Rule, len(c.ASNs)),
+	}
+	for _, i := range c.Exact {
+		r, err := rule(i)
+		if err != nil {
+			return nil, err
+		}
+		m.addExact(r, int(i))
+	}
+	m.finishExact(rules)
+	for k, i := range c.MACs {
+		r, err := rule(i)
+		if err != nil {
+			return nil, err
+		}
+		m.macs[k] = r
+	}
+	for k, i := range c.OUIs {
+		r, err := rule(i)
+		if err != nil {
+			return nil, err
+		}
+		m.ouis[k] = r
+	}
+	for k, i := range c.ASNs {
+		r, err := rule(i)
+		if err != nil {
+			return nil, err
+		}
+		m.asns[k] = r
+	}
+
+	if len(c.Labels) > 0 {
+		nodes := make([]*labelNode, len(c.Labels))
+		for i, l := range c.Labels {
+			n := &labelNode{}
+			if l.Rule >= 0 {
+				r, err := rule(l.Rule)
+				if err != nil {
+					return nil, err
+				}
+				n.rule, n.order = r, int(l.Rule)
+			}
+			nodes[i] = n
+			if i == 0 {
+				continue
+			}
+			if l.Parent < 0 || int(l.Parent) >= i {
+				return nil, fmt.Errorf("bad wildcard node %d", i)
+			}
+			parent := nodes[l.Parent]
+			if parent.children == nil {
+				parent.children = make(map[string]*labelNode)
+			}
+			parent.children[l.Label] = n
+		}
+		m.wildcards = nodes[0]
+	}
+
+	if len(c.CIDR4) > 0 || len(c.CIDR6) > 0 {
+		v4, err := loadCIDRTrie(c.CIDR4, rule)
+		if err != nil {
+			return nil, err
+		}
+		v6, err := loadCIDRTrie(c.CIDR6, rule)
+		if err != nil {
+			return nil, err
+		}
+		m.cidrMatcher = &CIDRMatcher{v4: v4, v6: v6}
+	}
+	return m, nil
+}
+
+// loadCIDRTrie rebuilds a trie flattened by compileCIDRTrie
+func loadCIDRTrie(flat []int32, rule func(int32) (*Rule, error)) (*cidrNode, error) {
+	if len(flat) == 0 {
+		return &cidrNode{}, nil
+	}
+	if len(flat)%3 != 0 {
+		return nil, fmt.Errorf("bad CIDR trie of %d values", len(flat))
+	}
+	nodes := make([]cidrNode, len(flat)/3)
+	for i := range nodes {
+		f := flat[i*3 : i*3+3]
+		if f[2] >= 0 {
+			r, err := rule(f[2])
+			if err != nil {
+				return nil, err
+			}
+			nodes[i].rule, nodes[i].order = r, int(f[2])
+		}
+		for bit, child := range f[:2] {
+			if child == 0 {
+				continue
+			}
+			if int(child) <= i || int(child) >= len(nodes) {
+				return nil, fmt.Errorf("bad CIDR node %d", child)
+			}
+			nodes[i].child[bit] = &nodes[child]
+		}
+	}
+	return &nodes[0], nil
+}
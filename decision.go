@@ -0,0 +1,118 @@
+package anot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Decision is what became of one line
+type Decision struct {
+	// Line is the line as read, Output as written after the action of the
+	// rule matching it; Output is empty when the line was removed
+	Line   string
+	Output string
+	// Removed tells whether the line went
+	Removed bool
+	// Pattern is the pattern matching the line and Type its kind, such as
+	// exact, wildcard or cidr, both empty for no match
+	Pattern string
+	Type    string
+	// N is the number of the line, from 1
+	N int
+	// Rule is the rule matching the line, nil for no match
+	Rule *Rule
+}
+
+// Decisions reads the decisions on the lines of a stream one at a time,
+// like a bufio.Scanner:
+//
+//	d := NewDecisions(src, m, FilterOptions{})
+//	for d.Next() {
+//		report(d.Decision())
+//	}
+//	if err := d.Err(); err != nil {
+//		...
+//	}
+type Decisions struct {
+	scanner *bufio.Scanner
+	maxLine int
+	m       *Matcher
+	opts    FilterOptions
+	current Decision
+}
+
+// NewDecisions returns the decisions of m on the lines of src; the Observe
+// option is left to the caller
+func NewDecisions(src io.Reader, m *Matcher, opts FilterOptions) *Decisions {
+	maxLine := opts.MaxLineSize
+	if maxLine <= 0 {
+		maxLine = DefaultMaxLineSize
+	}
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLine)
+	return &Decisions{scanner: scanner, maxLine: maxLine, m: m, opts: opts}
+}
+
+// Next decides on the next line, returning false at the end of the stream
+// or on an error
+func (d *Decisions) Next() bool {
+	if !d.scanner.Scan() {
+		return false
+	}
+	line := d.scanner.Text()
+	key := line
+	if d.opts.Trim {
+		key = strings.TrimSpace(line)
+	}
+	m := d.m
+	if d.opts.Live != nil {
+		m = d.opts.Live.Load()
+	}
+	rule := m.Match(key)
+	out, keep := line, rule == nil
+	switch {
+	case d.opts.KeepMatched:
+		keep = !keep
+	case rule != nil:
+		out, keep = rule.Apply(line)
+	}
+	decision := Decision{Line: line, Removed: !keep, N: d.current.N + 1, Rule: rule}
+	if keep {
+		decision.Output = out
+	}
+	if rule != nil {
+		decision.Pattern, decision.Type = rule.Pattern, rule.Type()
+	}
+	d.current = decision
+	return true
+}
+
+// Decision returns the decision Next made
+func (d *Decisions) Decision() Decision {
+	return d.current
+}
+
+// Err returns the error reading the stream ended with, nil at its end. A
+// line over MaxLineSize is an error that is bufio.ErrTooLong.
+func (d *Decisions) Err() error {
+	err := d.scanner.Err()
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("line %d is longer than %d bytes: %w", d.current.N+1, d.maxLine, err)
+	}
+	return err
+}
+
+// Decide calls fn with the decision on every line of src in turn, stopping
+// at the first error fn returns
+func Decide(src io.Reader, m *Matcher, opts FilterOptions, fn func(Decision) error) error {
+	d := NewDecisions(src, m, opts)
+	for d.Next() {
+		if err := fn(d.Decision()); err != nil {
+			return err
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,12 @@
+// Package anot matches lines against scope patterns the way the anot
+// command does: exact values, *.wildcard domains, CIDR ranges, asn:NUMBER
+// autonomous systems and MAC addresses and vendor prefixes, each rule with
+// the action of the extended pattern syntax.
+//
+// A Matcher is built once from the rules and is then safe for concurrent
+// Match calls; a LiveMatcher swaps Matchers in while it is in use. Filter
+// runs a Matcher over any stream, an io.Reader to an io.Writer, as anot
+// does to a file, and Decisions and Decide report what became of every
+// line instead, for tools doing their own reporting. The command itself is
+// in cmd/anot.
+package anot
@@ -0,0 +1,43 @@
+package anot_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hasshido/anot"
+)
+
+func ExampleFilter() {
+	rules, err := anot.ReadRules(strings.NewReader("*.example.com\n10.0.0.0/8\nold.example.org replace new.example.org\n"), true, true)
+	if err != nil {
+		panic(err)
+	}
+	m := anot.NewMatcher(rules)
+
+	src := strings.NewReader("www.example.com\n10.1.2.3\nold.example.org\nexample.net\n")
+	if err := anot.Filter(os.Stdout, src, m, anot.FilterOptions{}); err != nil {
+		panic(err)
+	}
+	// Output:
+	// new.example.org
+	// example.net
+}
+
+func ExampleDecisions() {
+	m := anot.NewMatcher([]*anot.Rule{{Pattern: "*.example.com"}, {Pattern: "10.0.0.0/8"}})
+
+	d := anot.NewDecisions(strings.NewReader("www.example.com\nexample.net\n10.1.2.3\n"), m, anot.FilterOptions{})
+	for d.Next() {
+		decision := d.Decision()
+		if decision.Removed {
+			fmt.Printf("%d: %s removed by %s (%s)\n", decision.N, decision.Line, decision.Pattern, decision.Type)
+		}
+	}
+	if err := d.Err(); err != nil {
+		panic(err)
+	}
+	// Output:
+	// 1: www.example.com removed by *.example.com (wildcard)
+	// 3: 10.1.2.3 removed by 10.0.0.0/8 (cidr)
+}
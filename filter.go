@@ -0,0 +1,52 @@
+package anot
+
+import (
+	"bufio"
+	"io"
+)
+
+// DefaultMaxLineSize is the longest line Filter accepts unless told
+// otherwise
+const DefaultMaxLineSize = 1 << 20
+
+// FilterOptions tunes Filter; the zero value filters like anot does by
+// default
+type FilterOptions struct {
+	// Trim matches lines without their leading and trailing whitespace
+	Trim bool
+	// KeepMatched inverts the filter: matched lines pass untouched and the
+	// others go
+	KeepMatched bool
+	// Live, when set, matches every line with the Matcher it holds at the
+	// time instead of the one given, so the patterns may change mid-stream
+	Live *LiveMatcher
+	// MaxLineSize is the longest line accepted, 1 MiB when zero
+	MaxLineSize int
+	// Observe, when set, is called for every line with the rule matching
+	// it, or nil, and whether the line was written
+	Observe func(rule *Rule, kept bool)
+}
+
+// Filter copies the lines of src to dst, without those m removes and with
+// the actions of the rules applied, as anot does to a file. Lines are
+// written as they are filtered, in bounded memory, so src can be any
+// stream, a request body or a pipe, and nothing touches the filesystem.
+func Filter(dst io.Writer, src io.Reader, m *Matcher, opts FilterOptions) error {
+	bw := bufio.NewWriter(dst)
+	d := NewDecisions(src, m, opts)
+	for d.Next() {
+		decision := d.Decision()
+		if opts.Observe != nil {
+			opts.Observe(decision.Rule, !decision.Removed)
+		}
+		if decision.Removed {
+			continue
+		}
+		bw.WriteString(decision.Output)
+		bw.WriteByte('\n')
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return d.Err()
+}
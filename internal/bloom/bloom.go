@@ -0,0 +1,61 @@
+// Package bloom is the bloom filter fronting the large exact pattern sets
+// of anot, in memory and on disk.
+package bloom
+
+// Filter is a probabilistic set answering "definitely not present"
+// without touching the much larger exact-match map, which for tens of
+// millions of patterns keeps the common no-match case in cache
+type Filter struct {
+	bits []uint64
+	mask uint64
+	k    uint64
+}
+
+// bloomBitsPerKey and bloomHashes give a false positive rate around 1%
+const (
+	bloomBitsPerKey = 10
+	bloomHashes     = 7
+)
+
+// New sizes a filter for n keys
+func New(n int) *Filter {
+	size := uint64(64)
+	for size < uint64(n)*bloomBitsPerKey {
+		size <<= 1
+	}
+	return &Filter{bits: make([]uint64, size/64), mask: size - 1, k: bloomHashes}
+}
+
+// bloomHash is 64-bit FNV-1a, inlined to hash strings without allocating
+func bloomHash(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// Add adds s to the set
+func (b *Filter) Add(s string) {
+	h := bloomHash(s)
+	// Double hashing derives the k probes from two halves of one hash
+	h1, h2 := h, h>>32|1
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) & b.mask
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// MayContain reports false when s was certainly never added
+func (b *Filter) MayContain(s string) bool {
+	h := bloomHash(s)
+	h1, h2 := h, h>>32|1
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) & b.mask
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
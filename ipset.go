@@ -0,0 +1,212 @@
+package anot
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"sort"
+)
+
+// ipSetMinimum is how many exact IP patterns it takes to keep them as
+// sorted numbers: below it the string map is just as small and faster
+const ipSetMinimum = 1 << 16
+
+// ipSet holds a large set of exact IP patterns as sorted numbers searched
+// in O(log n), a few bytes per address where a string map spends around a
+// hundred. Rules are shared between addresses with the same action and
+// argument; the pattern of a match is the line itself, and get returns a
+// copy of the shared rule carrying it.
+type ipSet struct {
+	v4      []uint32
+	v4Rules []uint32
+	v6      []u128
+	v6Rules []uint32
+	rules   []*Rule
+}
+
+// ipPatterns collects the exact IP patterns while a matcher is built, as
+// numbers and the position of their rule in the pattern list, until the
+// matcher decides between the string map and an ipSet
+type ipPatterns struct {
+	v4 []pendingIP4
+	v6 []pendingIP6
+}
+
+type pendingIP4 struct {
+	addr  uint32
+	order uint32
+}
+
+type pendingIP6 struct {
+	addr  u128
+	order uint32
+}
+
+func (p *ipPatterns) add(addr netip.Addr, order int) {
+	if addr.Is4() {
+		a := addr.As4()
+		p.v4 = append(p.v4, pendingIP4{binary.BigEndian.Uint32(a[:]), uint32(order)})
+	} else {
+		p.v6 = append(p.v6, pendingIP6{addrToU128(addr), uint32(order)})
+	}
+}
+
+func (p *ipPatterns) len() int {
+	return len(p.v4) + len(p.v6)
+}
+
+// orders returns the rule positions in the order the patterns were added
+func (p *ipPatterns) orders() []uint32 {
+	orders := make([]uint32, 0, p.len())
+	for _, e := range p.v4 {
+		orders = append(orders, e.order)
+	}
+	for _, e := range p.v6 {
+		orders = append(orders, e.order)
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i] < orders[j] })
+	return orders
+}
+
+// canonicalIP parses an exact pattern that is an IP address written the
+// way netip prints it, which is the only way a line can spell that exact
+// pattern and parse to the same address. Dotted IPv4 only parses in that
+// form, IPv6 needs checking.
+func canonicalIP(pattern string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(pattern)
+	if err != nil || addr.Zone() != "" || !addr.Is4() && addr.String() != pattern {
+		return netip.Addr{}, false
+	}
+	return addr, true
+}
+
+// zonedIP returns the canonical form of a pattern that is an IPv6 address
+// with a zone, such as fe80::1%eth0; zones are compared as they are
+func zonedIP(pattern string) (string, bool) {
+	addr, err := netip.ParseAddr(pattern)
+	if err != nil || addr.Zone() == "" {
+		return "", false
+	}
+	return addr.String(), true
+}
+
+// newIPSet builds the set from the collected patterns and their rules; of
+// patterns for the same address the first one wins, as in the string map
+func newIPSet(p *ipPatterns, rules []*Rule) *ipSet {
+	sort.Sort(byIP4(p.v4))
+	sort.Sort(byIP6(p.v6))
+
+	type shared struct {
+		action Action
+		arg    string
+	}
+	index := make(map[shared]uint32)
+	s := &ipSet{}
+	var last shared
+	lastRule := -1
+	ruleOf := func(order uint32) uint32 {
+		r := rules[order]
+		key := shared{r.Action, r.Arg}
+		// Blocklists mostly share one action, skip the map for runs of it
+		if lastRule >= 0 && key == last {
+			return uint32(lastRule)
+		}
+		n, ok := index[key]
+		if !ok {
+			n = uint32(len(s.rules))
+			index[key] = n
+			s.rules = append(s.rules, &Rule{Action: r.Action, Arg: r.Arg})
+		}
+		last, lastRule = key, int(n)
+		return n
+	}
+
+	s.v4 = make([]uint32, 0, len(p.v4))
+	s.v4Rules = make([]uint32, 0, len(p.v4))
+	for i, e := range p.v4 {
+		if i == 0 || e.addr != p.v4[i-1].addr {
+			s.v4 = append(s.v4, e.addr)
+			s.v4Rules = append(s.v4Rules, ruleOf(e.order))
+		}
+	}
+	s.v6 = make([]u128, 0, len(p.v6))
+	s.v6Rules = make([]uint32, 0, len(p.v6))
+	for i, e := range p.v6 {
+		if i == 0 || e.addr != p.v6[i-1].addr {
+			s.v6 = append(s.v6, e.addr)
+			s.v6Rules = append(s.v6Rules, ruleOf(e.order))
+		}
+	}
+	return s
+}
+
+// byIP4 and byIP6 sort collected patterns by address, then by position
+type byIP4 []pendingIP4
+
+func (p byIP4) Len() int      { return len(p) }
+func (p byIP4) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p byIP4) Less(i, j int) bool {
+	if p[i].addr != p[j].addr {
+		return p[i].addr < p[j].addr
+	}
+	return p[i].order < p[j].order
+}
+
+type byIP6 []pendingIP6
+
+func (p byIP6) Len() int      { return len(p) }
+func (p byIP6) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p byIP6) Less(i, j int) bool {
+	if p[i].addr != p[j].addr {
+		return p[i].addr.less(p[j].addr)
+	}
+	return p[i].order < p[j].order
+}
+
+// u128 is an IPv6 address as an unsigned 128-bit integer
+type u128 struct {
+	hi, lo uint64
+}
+
+func (a u128) less(b u128) bool {
+	return a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo)
+}
+
+// addrToU128 returns an IPv6 address as a number
+func addrToU128(addr netip.Addr) u128 {
+	a := addr.As16()
+	return u128{binary.BigEndian.Uint64(a[:8]), binary.BigEndian.Uint64(a[8:])}
+}
+
+// get returns the rule of the exact pattern line, parsed as addr, or nil
+func (s *ipSet) get(addr netip.Addr, line string) *Rule {
+	if addr.Is4() {
+		a := addr.As4()
+		v := binary.BigEndian.Uint32(a[:])
+		i := sort.Search(len(s.v4), func(i int) bool { return s.v4[i] >= v })
+		if i < len(s.v4) && s.v4[i] == v {
+			return s.rule(s.v4Rules[i], line)
+		}
+		return nil
+	}
+	v := addrToU128(addr)
+	i := sort.Search(len(s.v6), func(i int) bool { return !s.v6[i].less(v) })
+	// IPv6 can be spelled many ways, the pattern only matches its own
+	if i < len(s.v6) && s.v6[i] == v && addr.String() == line {
+		return s.rule(s.v6Rules[i], line)
+	}
+	return nil
+}
+
+// rule returns shared rule n with its pattern, the line that matched it,
+// which is always spelled as the pattern was
+func (s *ipSet) rule(n uint32, line string) *Rule {
+	r := *s.rules[n]
+	r.Pattern = line
+	return &r
+}
+
+// has reports whether the rule of an address is equivalent to rule
+func (s *ipSet) has(addr netip.Addr, rule *Rule) bool {
+	r := s.get(addr, addr.String())
+	return r != nil && r.Action == rule.Action && r.Arg == rule.Arg
+}
@@ -0,0 +1,67 @@
+package anot
+
+import "strings"
+
+// normalizeMAC returns a MAC address as 12 lower-case hex digits, accepting
+// the colon, dash, Cisco dot and bare notations, or "" if s is not a MAC
+func normalizeMAC(s string) string {
+	switch len(s) {
+	case 12:
+		// aabbccddeeff
+	case 14:
+		// aabb.ccdd.eeff
+		if s[4] != '.' || s[9] != '.' {
+			return ""
+		}
+	case 17:
+		// aa:bb:cc:dd:ee:ff or aa-bb-cc-dd-ee-ff
+		sep := s[2]
+		if sep != ':' && sep != '-' {
+			return ""
+		}
+		for i := 2; i < 17; i += 3 {
+			if s[i] != sep {
+				return ""
+			}
+		}
+	default:
+		return ""
+	}
+	return hexDigits(s, 12)
+}
+
+// normalizeOUI returns the 6 hex digit vendor prefix of an OUI pattern such
+// as 00:1A:2B:*, 00-1a-2b-* or 001A2B*, or "" if s is not one
+func normalizeOUI(s string) string {
+	if !strings.HasSuffix(s, "*") {
+		return ""
+	}
+	s = strings.TrimRight(s[:len(s)-1], ":-.")
+	if len(s) != 6 && len(s) != 7 && len(s) != 8 {
+		return ""
+	}
+	return hexDigits(s, 6)
+}
+
+// hexDigits lower-cases the hex digits of s, skipping MAC separators, and
+// returns "" unless exactly n digits and nothing else were found
+func hexDigits(s string, n int) string {
+	var b strings.Builder
+	b.Grow(n)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f':
+			b.WriteByte(c)
+		case c >= 'A' && c <= 'F':
+			b.WriteByte(c + 'a' - 'A')
+		case c == ':' || c == '-' || c == '.':
+		default:
+			return ""
+		}
+	}
+	if b.Len() != n {
+		return ""
+	}
+	return b.String()
+}
@@ -0,0 +1,565 @@
+package anot
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hasshido/anot/internal/bloom"
+)
+
+// CIDRMatcher pre-parses CIDR ranges into binary tries (one per address
+// family) so an IP is matched in at most 32 or 128 steps, however many
+// ranges there are
+type CIDRMatcher struct {
+	v4, v6 *cidrNode
+}
+
+// cidrNode is a node of a binary trie over address bits. A node with a
+// rule terminates a range; order is the rule's position in the pattern
+// list, so that the first pattern containing an IP wins as before.
+type cidrNode struct {
+	child [2]*cidrNode
+	rule  *Rule
+	order int
+}
+
+// NewCIDRMatcher creates a new CIDR matcher with pre-parsed networks
+func NewCIDRMatcher(rules []*Rule) *CIDRMatcher {
+	matcher := &CIDRMatcher{v4: &cidrNode{}, v6: &cidrNode{}}
+	for i, rule := range rules {
+		if prefix, err := netip.ParsePrefix(rule.Pattern); err == nil {
+			matcher.insert(prefix.Masked(), rule, i)
+		}
+	}
+	return matcher
+}
+
+// addrBytes returns the address bytes, 4 for IPv4 and 16 for IPv6, in a
+// fixed array so nothing is allocated
+func addrBytes(addr netip.Addr) ([16]byte, int) {
+	if addr.Is4() {
+		var b [16]byte
+		a4 := addr.As4()
+		copy(b[:], a4[:])
+		return b, 4
+	}
+	return addr.As16(), 16
+}
+
+// insert adds a network to the trie of its address family. IPv4-mapped
+// IPv6 ranges stay in the IPv6 trie, as net did.
+func (c *CIDRMatcher) insert(prefix netip.Prefix, rule *Rule, order int) {
+	node := c.v6
+	if prefix.Addr().Is4() {
+		node = c.v4
+	}
+	b, _ := addrBytes(prefix.Addr())
+	for i := 0; i < prefix.Bits(); i++ {
+		bit := b[i/8] >> (7 - uint(i%8)) & 1
+		if node.child[bit] == nil {
+			node.child[bit] = &cidrNode{}
+		}
+		node = node.child[bit]
+	}
+	if node.rule == nil {
+		node.rule, node.order = rule, order
+	}
+}
+
+// Contains checks if an IP is contained in any of the CIDR ranges
+func (c *CIDRMatcher) Contains(addr netip.Addr) bool {
+	return c.Match(addr) != nil
+}
+
+// Match returns the rule of the first CIDR range containing the IP, or nil.
+// IPv4-mapped IPv6 addresses match as IPv4.
+func (c *CIDRMatcher) Match(addr netip.Addr) *Rule {
+	addr = addr.Unmap()
+	node := c.v6
+	if addr.Is4() {
+		node = c.v4
+	}
+	b, n := addrBytes(addr)
+
+	var best *cidrNode
+	for i := 0; node != nil; i++ {
+		if node.rule != nil && (best == nil || node.order < best.order) {
+			best = node
+		}
+		if i == n*8 {
+			break
+		}
+		node = node.child[b[i/8]>>(7-uint(i%8))&1]
+	}
+	if best == nil {
+		return nil
+	}
+	return best.rule
+}
+
+// Matcher holds the removal rules, grouped by pattern type so each line
+// is only compared against the patterns that can actually match it.
+// Once set up, a Matcher is safe for concurrent Match calls; the Enable
+// and Set methods are not, and are for before it is shared. Patterns in
+// use are changed by swapping in another Matcher, see LiveMatcher.
+type Matcher struct {
+	exactMatches map[string]*Rule
+	// bloom optionally fronts exactMatches for huge pattern sets
+	bloom *bloom.Filter
+	// spilled holds the exact matches kept outside the matcher
+	spilled ExactSet
+	// ips holds the exact IP patterns of large IP sets instead of the map;
+	// pendingIPs collects them while the matcher is built
+	ips        *ipSet
+	pendingIPs ipPatterns
+	// zoned tells whether exactMatches has zoned IPv6 addresses, keyed by
+	// their canonical form
+	zoned bool
+	// ports tells whether exactMatches has :PORT patterns
+	ports       bool
+	wildcards   *labelNode
+	cidrMatcher *CIDRMatcher
+	// MAC addresses and OUI vendor prefixes, keyed by normalized hex digits
+	macs map[string]*Rule
+	ouis map[string]*Rule
+	// asn:NUMBER patterns, matched against IPs through asnDB
+	asns  map[uint32]*Rule
+	asnDB ASNDatabase
+	// hooks run around the patterns, if any
+	hooks *Hooks
+}
+
+// Hooks are run around the patterns: Clean, when set, cleans up each value
+// as the patterns were, the Normalize functions then rewrite it before it
+// is matched, Exprs remove what no pattern matched and KeepIf keeps what
+// was matched after all
+type Hooks struct {
+	Clean     func(string) string
+	Normalize []func(string) string
+	Exprs     []*Expr
+	KeepIf    []*Expr
+}
+
+// Expr is a predicate on the value matched, such as an --expr expression
+// of anot; Rule is the rule a value it holds for matches
+type Expr struct {
+	Eval func(line string) bool
+	Rule *Rule
+}
+
+// ExactSet is a set of exact patterns kept outside the Matcher, such as
+// the disk-backed table huge pattern lists spill to
+type ExactSet interface {
+	// Get returns the rule of an exact pattern, or nil
+	Get(pattern string) *Rule
+}
+
+// NewMatcher categorizes the rules by pattern type and pre-compiles them
+func NewMatcher(rules []*Rule) *Matcher {
+	m := &Matcher{
+		exactMatches: make(map[string]*Rule),
+		macs:         make(map[string]*Rule),
+		ouis:         make(map[string]*Rule),
+		asns:         make(map[uint32]*Rule),
+	}
+	for i, rule := range rules {
+		pattern := rule.Pattern
+		if strings.HasPrefix(pattern, "*.") {
+			// Wildcard pattern for domains
+			if m.wildcards == nil {
+				m.wildcards = &labelNode{}
+			}
+			m.wildcards.insert(pattern[2:], rule, i)
+		} else if strings.Contains(pattern, "/") {
+			// Potential CIDR range
+			if prefix, err := netip.ParsePrefix(pattern); err == nil {
+				if m.cidrMatcher == nil {
+					m.cidrMatcher = &CIDRMatcher{v4: &cidrNode{}, v6: &cidrNode{}}
+				}
+				m.cidrMatcher.insert(prefix.Masked(), rule, i)
+			} else {
+				// Not a valid CIDR, treat as exact match
+				m.addExact(rule, i)
+			}
+		} else if asn, ok := parseASNPattern(pattern); ok {
+			// Autonomous system, needs an ASN database to match anything
+			if _, ok := m.asns[asn]; !ok {
+				m.asns[asn] = rule
+			}
+		} else if oui := normalizeOUI(pattern); oui != "" {
+			// MAC vendor prefix such as 00:1A:2B:*
+			if _, ok := m.ouis[oui]; !ok {
+				m.ouis[oui] = rule
+			}
+		} else {
+			// Exact match (domain, IP, or other string)
+			m.addExact(rule, i)
+			// MAC addresses also match whatever notation the line uses
+			if mac := normalizeMAC(pattern); mac != "" {
+				if _, ok := m.macs[mac]; !ok {
+					m.macs[mac] = rule
+				}
+			}
+		}
+	}
+	m.finishExact(rules)
+	return m
+}
+
+// EnableBloom puts a bloom filter in front of the exact matches, which pays
+// off once there are millions of them
+func (m *Matcher) EnableBloom() {
+	m.bloom = bloom.New(len(m.exactMatches))
+	for pattern := range m.exactMatches {
+		m.bloom.Add(pattern)
+	}
+}
+
+// SetHooks runs hooks around every match
+func (m *Matcher) SetHooks(hooks *Hooks) {
+	m.hooks = hooks
+}
+
+// SetExactSet adds exact matches kept outside the matcher
+func (m *Matcher) SetExactSet(set ExactSet) {
+	m.spilled = set
+}
+
+// HasASNPatterns reports whether any asn: pattern was given
+func (m *Matcher) HasASNPatterns() bool {
+	return len(m.asns) > 0
+}
+
+// HasPortPatterns reports whether any :PORT pattern was given
+func (m *Matcher) HasPortPatterns() bool {
+	return m.ports
+}
+
+// isPortPattern reports whether a pattern is a :PORT one, such as :443
+func isPortPattern(pattern string) bool {
+	if len(pattern) < 2 || pattern[0] != ':' {
+		return false
+	}
+	for i := 1; i < len(pattern); i++ {
+		if pattern[i] < '0' || pattern[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// SetASNDatabase enables matching asn: patterns against IPs
+func (m *Matcher) SetASNDatabase(db ASNDatabase) {
+	if len(m.asns) > 0 {
+		m.asnDB = db
+	}
+}
+
+// addExact registers an exact match rule, the first rule for a pattern wins.
+// IP addresses are set aside until finishExact, order being the rule's
+// position in the pattern list.
+func (m *Matcher) addExact(rule *Rule, order int) {
+	if addr, ok := canonicalIP(rule.Pattern); ok {
+		m.pendingIPs.add(addr, order)
+		return
+	}
+	pattern := rule.Pattern
+	if isPortPattern(pattern) {
+		m.ports = true
+	}
+	if zoned, ok := zonedIP(pattern); ok {
+		// Matched by address and zone, however the address is spelled
+		pattern, m.zoned = zoned, true
+	}
+	if _, ok := m.exactMatches[pattern]; !ok {
+		m.exactMatches[pattern] = rule
+	}
+}
+
+// finishExact stores the exact IP patterns, as sorted numbers when there
+// are many of them and in the string map otherwise. rules is the pattern
+// list the orders given to addExact refer to.
+func (m *Matcher) finishExact(rules []*Rule) {
+	if m.pendingIPs.len() >= ipSetMinimum {
+		m.ips = newIPSet(&m.pendingIPs, rules)
+	} else {
+		for _, order := range m.pendingIPs.orders() {
+			rule := rules[order]
+			if _, ok := m.exactMatches[rule.Pattern]; !ok {
+				m.exactMatches[rule.Pattern] = rule
+			}
+		}
+	}
+	m.pendingIPs = ipPatterns{}
+}
+
+// Match returns the rule matching the line, or nil if the line should be kept
+func (m *Matcher) Match(line string) *Rule {
+	if m.hooks == nil {
+		return m.match(line)
+	}
+	line = m.hooks.normalized(line)
+	rule := m.match(line)
+	if rule == nil {
+		for _, e := range m.hooks.Exprs {
+			if e.Eval(line) {
+				rule = e.Rule
+				break
+			}
+		}
+	}
+	if rule != nil && m.kept(line) {
+		return nil
+	}
+	return rule
+}
+
+// Kept reports whether a --keep-if expression holds for the line, which
+// stays whatever else matches it
+func (m *Matcher) Kept(line string) bool {
+	if m.hooks == nil || len(m.hooks.KeepIf) == 0 {
+		return false
+	}
+	line = m.hooks.normalized(line)
+	return m.kept(line)
+}
+
+// normalized returns the value matched in place of line
+func (h *Hooks) normalized(line string) string {
+	if h.Clean != nil {
+		line = h.Clean(line)
+	}
+	for _, f := range h.Normalize {
+		line = f(line)
+	}
+	return line
+}
+
+func (m *Matcher) kept(normalized string) bool {
+	for _, e := range m.hooks.KeepIf {
+		if e.Eval(normalized) {
+			return true
+		}
+	}
+	return false
+}
+
+// match runs the patterns alone
+// This optimized version minimizes repeated parsing and uses pre-compiled matchers
+func (m *Matcher) match(line string) *Rule {
+	// Check for exact match first (fastest lookup)
+	if m.bloom == nil || m.bloom.MayContain(line) {
+		if rule, ok := m.exactMatches[line]; ok {
+			return rule
+		}
+	}
+	if m.spilled != nil {
+		if rule := m.spilled.Get(line); rule != nil {
+			return rule
+		}
+	}
+
+	// Parse IP once and check exact IPs, CIDR ranges and ASNs if it's a valid IP
+	if m.ips != nil || m.cidrMatcher != nil || m.asnDB != nil || m.zoned {
+		// netip parses without allocating; zoned addresses only match
+		// zoned patterns, by their canonical form
+		if addr, err := netip.ParseAddr(line); err == nil && addr.Zone() != "" {
+			if rule, ok := m.exactMatches[addr.String()]; ok {
+				return rule
+			}
+		} else if err == nil {
+			if m.ips != nil {
+				if rule := m.ips.get(addr, line); rule != nil {
+					return rule
+				}
+			}
+			if m.cidrMatcher != nil {
+				if rule := m.cidrMatcher.Match(addr); rule != nil {
+					return rule
+				}
+			}
+			if m.asnDB != nil {
+				if asn, ok := m.asnDB.LookupASN(net.IP(addr.AsSlice())); ok {
+					if rule, ok := m.asns[asn]; ok {
+						return rule
+					}
+				}
+			}
+		}
+	}
+
+	// Normalize MAC addresses only when there are MAC patterns at all
+	if len(m.macs) > 0 || len(m.ouis) > 0 {
+		if mac := normalizeMAC(line); mac != "" {
+			if rule, ok := m.macs[mac]; ok {
+				return rule
+			}
+			if rule, ok := m.ouis[mac[:6]]; ok {
+				return rule
+			}
+		}
+	}
+
+	// Check wildcard patterns (only for non-IP strings to avoid unnecessary work)
+	if m.wildcards != nil && !strings.Contains(line, ":") && !isNumericIP(line) {
+		if rule := m.wildcards.match(line); rule != nil {
+			return rule
+		}
+	}
+
+	return nil
+}
+
+// MatchKeys returns the rule matching the first matching key, or nil
+func (m *Matcher) MatchKeys(keys []string) *Rule {
+	for _, key := range keys {
+		if rule := m.Match(key); rule != nil {
+			return rule
+		}
+	}
+	return nil
+}
+
+// LiveMatcher holds the Matcher in use by a long running mode or an
+// embedder, for any number of concurrent Match calls while the patterns
+// change. A Matcher in use is never modified: an update builds another and
+// swaps it in, and calls in flight finish with the one they started with.
+// Reads take no lock.
+type LiveMatcher struct {
+	v atomic.Value
+	// mu serializes the swaps, so no update is lost
+	mu sync.Mutex
+}
+
+// NewLiveMatcher returns a LiveMatcher using m
+func NewLiveMatcher(m *Matcher) *LiveMatcher {
+	l := &LiveMatcher{}
+	l.v.Store(m)
+	return l
+}
+
+// Load returns the Matcher in use, nil before the first Store
+func (l *LiveMatcher) Load() *Matcher {
+	m, _ := l.v.Load().(*Matcher)
+	return m
+}
+
+// Store swaps in m
+func (l *LiveMatcher) Store(m *Matcher) {
+	l.mu.Lock()
+	l.v.Store(m)
+	l.mu.Unlock()
+}
+
+// Update swaps in the Matcher build makes from the one in use, which build
+// must leave unchanged; concurrent updates run one after the other. When
+// build fails the Matcher in use stays.
+func (l *LiveMatcher) Update(build func(current *Matcher) (*Matcher, error)) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, err := build(l.Load())
+	if err != nil {
+		return err
+	}
+	l.v.Store(m)
+	return nil
+}
+
+// Match matches the line with the Matcher in use
+func (l *LiveMatcher) Match(line string) *Rule {
+	return l.Load().Match(line)
+}
+
+// MatchKeys matches the keys with the Matcher in use, the same one for all
+func (l *LiveMatcher) MatchKeys(keys []string) *Rule {
+	return l.Load().MatchKeys(keys)
+}
+
+// labelNode is a node of a trie keyed on domain labels from right to left,
+// holding the wildcard patterns: *.customer.cloudways.com is stored under
+// com, cloudways, customer. A node with a rule ends a pattern; order is the
+// rule's position in the pattern list so the first matching pattern wins.
+type labelNode struct {
+	children map[string]*labelNode
+	rule     *Rule
+	order    int
+}
+
+// insert adds the suffix of a wildcard pattern (the part after "*.")
+func (n *labelNode) insert(suffix string, rule *Rule, order int) {
+	node := n
+	end := len(suffix)
+	for {
+		i := strings.LastIndexByte(suffix[:end], '.')
+		label := suffix[i+1 : end]
+		if node.children == nil {
+			node.children = make(map[string]*labelNode)
+		}
+		child, ok := node.children[label]
+		if !ok {
+			child = &labelNode{}
+			node.children[label] = child
+		}
+		node = child
+		if i < 0 {
+			break
+		}
+		end = i
+	}
+	if node.rule == nil {
+		node.rule, node.order = rule, order
+	}
+}
+
+// match returns the rule of the first wildcard pattern matching the line.
+// For example: "*.customer.cloudways.com" matches "test1.customer.cloudways.com"
+// but not "customer.cloudways.com". Lookups take one step per label.
+func (n *labelNode) match(line string) *Rule {
+	var best *labelNode
+	node := n
+	end := len(line)
+	for {
+		i := strings.LastIndexByte(line[:end], '.')
+		if i < 0 {
+			// The leftmost label can't be followed by a wildcard part
+			break
+		}
+		if node = node.children[line[i+1:end]]; node == nil {
+			break
+		}
+		// What precedes the suffix must be a non-empty label
+		if node.rule != nil && i > 0 && line[i-1] != '.' && (best == nil || node.order < best.order) {
+			best = node
+		}
+		end = i
+	}
+	if best == nil {
+		return nil
+	}
+	return best.rule
+}
+
+// isNumericIP quickly checks if a string looks like an IPv4 address without full parsing
+func isNumericIP(s string) bool {
+	dots, digits := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '.':
+			if digits == 0 {
+				return false
+			}
+			dots++
+			digits = 0
+		case c >= '0' && c <= '9':
+			if digits++; digits > 3 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return dots == 3 && digits > 0
+}
@@ -0,0 +1,203 @@
+package anot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+)
+
+// Action describes what happens to a line matched by a rule
+type Action int
+
+const (
+	// ActionRemove drops the matched line (the default)
+	ActionRemove Action = iota
+	// ActionComment keeps the line but comments it out with "# "
+	ActionComment
+	// ActionReplace swaps the whole line for the rule argument
+	ActionReplace
+	// ActionTag keeps the line and appends the rule argument as a trailing comment
+	ActionTag
+)
+
+var actionNames = map[string]Action{
+	"remove":  ActionRemove,
+	"comment": ActionComment,
+	"replace": ActionReplace,
+	"tag":     ActionTag,
+}
+
+// Rule is a single removal pattern together with the action to apply on match
+type Rule struct {
+	Pattern string
+	Action  Action
+	Arg     string
+}
+
+// ParseExtendedRule parses a line of the extended pattern syntax:
+//
+//	PATTERN [ACTION [ARG...]]
+//
+// where ACTION is one of remove, comment, replace or tag. replace and tag
+// take the rest of the line as argument, so it may contain spaces.
+// Blank lines and lines starting with "#" yield a nil rule.
+func ParseExtendedRule(line string) (*Rule, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+
+	fields := strings.Fields(line)
+	rule := &Rule{Pattern: fields[0]}
+	if len(fields) == 1 {
+		return rule, nil
+	}
+
+	action, ok := actionNames[fields[1]]
+	if !ok {
+		return nil, fmt.Errorf("unknown action %q for pattern %q", fields[1], rule.Pattern)
+	}
+	rule.Action = action
+
+	if len(fields) > 2 {
+		// Keep the argument verbatim, internal spacing included
+		rest := strings.TrimSpace(line[len(fields[0]):])
+		rule.Arg = strings.TrimSpace(rest[len(fields[1]):])
+	}
+
+	switch action {
+	case ActionReplace, ActionTag:
+		if rule.Arg == "" {
+			return nil, fmt.Errorf("action %q for pattern %q needs an argument", fields[1], rule.Pattern)
+		}
+	default:
+		if rule.Arg != "" {
+			return nil, fmt.Errorf("action %q for pattern %q takes no argument", fields[1], rule.Pattern)
+		}
+	}
+	return rule, nil
+}
+
+// Apply returns the line to write in place of a matched line, and false
+// when the line should be dropped entirely
+func (r *Rule) Apply(line string) (string, bool) {
+	switch r.Action {
+	case ActionComment:
+		return "# " + line, true
+	case ActionReplace:
+		return r.Arg, true
+	case ActionTag:
+		return line + " # " + r.Arg, true
+	default:
+		return "", false
+	}
+}
+
+// String returns the name of an action in the extended syntax
+func (a Action) String() string {
+	for name, action := range actionNames {
+		if action == a {
+			return name
+		}
+	}
+	return ""
+}
+
+// FormatRule writes a rule back in the extended syntax ParseExtendedRule
+// reads; plain removal rules are just their pattern
+func FormatRule(r *Rule) string {
+	switch {
+	case r.Action == ActionRemove:
+		return r.Pattern
+	case r.Arg != "":
+		return r.Pattern + " " + r.Action.String() + " " + r.Arg
+	default:
+		return r.Pattern + " " + r.Action.String()
+	}
+}
+
+// ReadRules reads one pattern per line. In extended mode lines follow the
+// extended syntax, otherwise every line (after optional trimming) is a plain
+// removal pattern.
+func ReadRules(r io.Reader, extended, trim bool) ([]*Rule, error) {
+	var rules []*Rule
+	err := EachRule(r, extended, trim, func(rule *Rule) error {
+		rules = append(rules, rule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// EachRule is ReadRules handing every rule to fn as it is read, so pattern
+// lists need not fit in memory
+func EachRule(r io.Reader, extended, trim bool, fn func(*Rule) error) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if extended {
+			rule, err := ParseExtendedRule(line)
+			if err != nil {
+				return err
+			}
+			if rule != nil {
+				if err := fn(rule); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if trim {
+			line = strings.TrimSpace(line)
+		}
+		if err := fn(&Rule{Pattern: line}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Type returns the kind of the pattern of the rule, see PatternType
+func (r *Rule) Type() string {
+	return PatternType(r.Pattern)
+}
+
+// PatternType returns the kind of a pattern, as NewMatcher sorts them:
+// expr, wildcard, cidr, asn, oui, mac, ip or exact. The empty pattern of a
+// blank line in a pattern file is one of its own, empty.
+func PatternType(pattern string) string {
+	switch {
+	case pattern == "":
+		return "empty"
+	case strings.HasPrefix(pattern, "expr:"):
+		return "expr"
+	case strings.HasPrefix(pattern, "*."):
+		return "wildcard"
+	case strings.Contains(pattern, "/") && isPrefix(pattern):
+		return "cidr"
+	}
+	if _, ok := parseASNPattern(pattern); ok {
+		return "asn"
+	}
+	if normalizeOUI(pattern) != "" {
+		return "oui"
+	}
+	if normalizeMAC(pattern) != "" {
+		return "mac"
+	}
+	if _, err := netip.ParseAddr(pattern); err == nil {
+		return "ip"
+	}
+	return "exact"
+}
+
+func isPrefix(pattern string) bool {
+	_, err := netip.ParsePrefix(pattern)
+	return err == nil
+}
@@ -0,0 +1,115 @@
+package anot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPatternType(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"", "empty"},
+		{"expr:len(line) > 80", "expr"},
+		{"*.example.com", "wildcard"},
+		{"10.0.0.0/8", "cidr"},
+		{"asn:13335", "asn"},
+		{"00:1A:2B:*", "oui"},
+		{"00:1a:2b:3c:4d:5e", "mac"},
+		{"10.0.0.1", "ip"},
+		{"fe80::1%eth0", "ip"},
+		{"example.com", "exact"},
+		{"a/b", "exact"},
+	}
+	for _, tt := range tests {
+		if got := PatternType(tt.pattern); got != tt.want {
+			t.Errorf("PatternType(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestRuleOfIPSet(t *testing.T) {
+	rules := make([]*Rule, ipSetMinimum)
+	for i := range rules {
+		rules[i] = &Rule{Pattern: fmt.Sprintf("10.%d.%d.1", i>>8, i&0xff)}
+	}
+	rules = append(rules,
+		&Rule{Pattern: "2001:db8::1", Action: ActionTag, Arg: "v6"},
+		&Rule{Pattern: "10.0.1.1", Action: ActionComment},
+		&Rule{Pattern: ""})
+	m := NewMatcher(rules)
+	if m.ips == nil {
+		t.Fatal("no sorted IP set")
+	}
+
+	tests := []struct {
+		line   string
+		action Action
+		arg    string
+	}{
+		// The first rule for an address wins
+		{"10.0.1.1", ActionRemove, ""},
+		{"10.255.255.1", ActionRemove, ""},
+		{"2001:db8::1", ActionTag, "v6"},
+	}
+	for _, tt := range tests {
+		rule := m.Match(tt.line)
+		if rule == nil {
+			t.Errorf("%s not matched", tt.line)
+			continue
+		}
+		if rule.Pattern != tt.line || rule.Action != tt.action || rule.Arg != tt.arg {
+			t.Errorf("%s matched %+v", tt.line, rule)
+		}
+		if got := rule.Type(); got != "ip" {
+			t.Errorf("Type of %s = %q, want ip", tt.line, got)
+		}
+	}
+	for _, line := range []string{"2001:db8:0::1", "10.0.1.2"} {
+		if rule := m.Match(line); rule != nil {
+			t.Errorf("%s matched %+v", line, rule)
+		}
+	}
+	if got := m.Match("").Type(); got != "empty" {
+		t.Errorf("Type of the blank pattern = %q, want empty", got)
+	}
+}
+
+func TestDecisionsOfIPSet(t *testing.T) {
+	rules := make([]*Rule, ipSetMinimum+1)
+	for i := range rules {
+		rules[i] = &Rule{Pattern: fmt.Sprintf("10.%d.%d.1", i>>8, i&0xff)}
+	}
+	m := NewMatcher(rules)
+	if m.ips == nil {
+		t.Fatal("no sorted IP set")
+	}
+
+	d := NewDecisions(strings.NewReader("10.0.7.1\nexample.com\n10.1.0.1\n"), m, FilterOptions{})
+	var got []Decision
+	for d.Next() {
+		got = append(got, d.Decision())
+	}
+	if err := d.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		pattern, typ string
+		removed      bool
+	}{
+		{"10.0.7.1", "ip", true},
+		{"", "", false},
+		{"10.1.0.1", "ip", true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%d decisions, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Pattern != w.pattern || got[i].Type != w.typ || got[i].Removed != w.removed {
+			t.Errorf("decision on %s = %+v, want pattern %q, type %q, removed %t",
+				got[i].Line, got[i], w.pattern, w.typ, w.removed)
+		}
+	}
+}